@@ -0,0 +1,44 @@
+package config
+
+// MonitorConfig defines configuration for the monitor.
+type MonitorConfig struct {
+	// LivenessChecker enables the alarm that fires when a verified
+	// checkpoint is not reported back to Babylon within the liveness window
+	LivenessChecker bool `mapstructure:"liveness-checker"`
+
+	// ReportForkEvidence enables submitting fraud-proof evidence to Babylon
+	// when the monitor observes two conflicting BTC checkpoints for the same
+	// epoch (i.e. VerifyCheckpoint fails with ErrInconsistentLastCommitHash)
+	ReportForkEvidence bool `mapstructure:"report-fork-evidence"`
+
+	// OracleFile points to a JSON/YAML file of operator-signed SyncCheckpoints
+	// the monitor can use to fast-sync instead of scanning BTC from genesis.
+	// Leave empty to always do a full scan.
+	OracleFile string `mapstructure:"oracle-file"`
+	// OracleSigners are the hex-encoded compressed secp256k1 public keys of
+	// the operators allowed to sign oracle checkpoints
+	OracleSigners []string `mapstructure:"oracle-signers"`
+	// OracleThreshold is the minimum number of valid OracleSigners signatures
+	// required before an oracle checkpoint is trusted
+	OracleThreshold int `mapstructure:"oracle-threshold"`
+
+	// APIListen is the address the monitor's read API listens on over HTTP,
+	// e.g. "127.0.0.1:9981". Leave empty to disable the API.
+	APIListen string `mapstructure:"api-listen"`
+	// GRPCListen is the address the monitor's read API listens on over gRPC,
+	// e.g. "127.0.0.1:9982". Leave empty to disable the gRPC API.
+	GRPCListen string `mapstructure:"grpc-listen"`
+
+	// MetricsListen is the address the monitor exposes /metrics on, e.g.
+	// "127.0.0.1:2112". Leave empty to disable metrics.
+	MetricsListen string `mapstructure:"metrics-listen"`
+}
+
+// DefaultMonitorConfig returns the default monitor configuration.
+func DefaultMonitorConfig() *MonitorConfig {
+	return &MonitorConfig{
+		LivenessChecker:    true,
+		ReportForkEvidence: false,
+		OracleThreshold:    1,
+	}
+}
@@ -0,0 +1,30 @@
+package alert
+
+import "context"
+
+// Alert is a single notification raised by the monitor, e.g. a detected BTC
+// fork or a liveness violation.
+type Alert struct {
+	// Title is a short, human-readable summary suitable for a notification
+	// subject line
+	Title string
+	// Details is a longer, freeform description of what triggered the alert
+	Details string
+	// Severity indicates how urgently the alert needs a human to look at it
+	Severity Severity
+}
+
+// Severity indicates how urgently an Alert needs attention.
+type Severity string
+
+const (
+	SeverityCritical Severity = "critical"
+	SeverityWarning  Severity = "warning"
+)
+
+// Alerter delivers Alerts to an external on-call system. Implementations
+// should treat Fire as best-effort: a delivery failure is returned to the
+// caller to log, not retried internally.
+type Alerter interface {
+	Fire(ctx context.Context, a Alert) error
+}
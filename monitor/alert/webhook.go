@@ -0,0 +1,101 @@
+package alert
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// WebhookAlerter POSTs a JSON-encoded Alert to a configured URL. It is the
+// building block PagerDutyAlerter and SlackAlerter format their payloads
+// around.
+type WebhookAlerter struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookAlerter creates a WebhookAlerter that POSTs to url.
+func NewWebhookAlerter(url string) *WebhookAlerter {
+	return &WebhookAlerter{url: url, client: http.DefaultClient}
+}
+
+func (a *WebhookAlerter) Fire(ctx context.Context, alert Alert) error {
+	return a.post(ctx, alert)
+}
+
+func (a *WebhookAlerter) post(ctx context.Context, payload any) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal alert payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to build alert request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to deliver alert to %s: %w", a.url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("alert webhook %s returned status %s", a.url, resp.Status)
+	}
+
+	return nil
+}
+
+// SlackAlerter posts an Alert to a Slack incoming webhook URL.
+type SlackAlerter struct {
+	webhook *WebhookAlerter
+}
+
+// NewSlackAlerter creates a SlackAlerter that posts to a Slack incoming
+// webhook URL.
+func NewSlackAlerter(webhookURL string) *SlackAlerter {
+	return &SlackAlerter{webhook: NewWebhookAlerter(webhookURL)}
+}
+
+func (a *SlackAlerter) Fire(ctx context.Context, alert Alert) error {
+	return a.webhook.post(ctx, map[string]string{
+		"text": fmt.Sprintf("[%s] %s\n%s", alert.Severity, alert.Title, alert.Details),
+	})
+}
+
+// PagerDutyAlerter triggers a PagerDuty Events API v2 incident for an Alert.
+type PagerDutyAlerter struct {
+	webhook    *WebhookAlerter
+	routingKey string
+}
+
+// NewPagerDutyAlerter creates a PagerDutyAlerter that triggers incidents
+// against the Events API v2 using routingKey.
+func NewPagerDutyAlerter(routingKey string) *PagerDutyAlerter {
+	return &PagerDutyAlerter{
+		webhook:    NewWebhookAlerter("https://events.pagerduty.com/v2/enqueue"),
+		routingKey: routingKey,
+	}
+}
+
+func (a *PagerDutyAlerter) Fire(ctx context.Context, alert Alert) error {
+	severity := "warning"
+	if alert.Severity == SeverityCritical {
+		severity = "critical"
+	}
+
+	return a.webhook.post(ctx, map[string]any{
+		"routing_key":  a.routingKey,
+		"event_action": "trigger",
+		"payload": map[string]string{
+			"summary":  alert.Title,
+			"source":   "vigilante-monitor",
+			"severity": severity,
+			"details":  alert.Details,
+		},
+	})
+}
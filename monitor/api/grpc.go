@@ -0,0 +1,114 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// jsonCodec marshals gRPC messages as JSON instead of protobuf, so the
+// FinalizedEpoch service can be served without protoc-generated types.
+type jsonCodec struct{}
+
+func (jsonCodec) Marshal(v interface{}) ([]byte, error)      { return json.Marshal(v) }
+func (jsonCodec) Unmarshal(data []byte, v interface{}) error { return json.Unmarshal(data, v) }
+func (jsonCodec) Name() string                               { return "json" }
+
+// FinalizedEpochRequest is the gRPC counterpart of the HTTP endpoint's query
+// parameters.
+type FinalizedEpochRequest struct {
+	BtcHeight uint64 `json:"btc_height"`
+	Prove     bool   `json:"prove"`
+}
+
+var finalizedEpochServiceDesc = grpc.ServiceDesc{
+	ServiceName: "vigilante.monitor.v1.FinalizedEpochService",
+	HandlerType: (*finalizedEpochServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "FinalizedEpoch",
+			Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, _ grpc.UnaryServerInterceptor) (interface{}, error) {
+				req := new(FinalizedEpochRequest)
+				if err := dec(req); err != nil {
+					return nil, err
+				}
+				return srv.(*finalizedEpochServer).FinalizedEpoch(ctx, req)
+			},
+		},
+	},
+}
+
+type finalizedEpochServer struct {
+	source FinalizedEpochSource
+}
+
+// FinalizedEpoch is the gRPC equivalent of handleFinalizedEpoch: it serves
+// the same finalizedIndex lookup over a grpc.Server instead of net/http.
+func (s *finalizedEpochServer) FinalizedEpoch(_ context.Context, req *FinalizedEpochRequest) (*finalizedEpochResponse, error) {
+	info, ok := s.source.QueryFinalizedInfoUntilHeight(req.BtcHeight)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "no finalized epoch found at or below BTC height %d", req.BtcHeight)
+	}
+
+	resp := &finalizedEpochResponse{
+		EpochNum:     info.EpochNum,
+		BtcHeight:    info.BtcHeight,
+		BtcBlockHash: info.BtcBlockHash.String(),
+	}
+	if info.RawCheckpoint != nil {
+		rawCkptBytes, err := info.RawCheckpoint.Marshal()
+		if err != nil {
+			return nil, status.Error(codes.Internal, "failed to marshal raw checkpoint")
+		}
+		resp.RawCheckpoint = rawCkptBytes
+	}
+	if req.Prove && len(info.Proofs) > 0 {
+		proofBytes, err := json.Marshal(info.Proofs)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "failed to marshal SPV proofs")
+		}
+		resp.Proofs = proofBytes
+	}
+
+	return resp, nil
+}
+
+// grpcServer wraps a grpc.Server exposing FinalizedEpochService, run
+// alongside Server's HTTP listener on a separate address.
+type grpcServer struct {
+	srv        *grpc.Server
+	listenAddr string
+}
+
+func newGRPCServer(source FinalizedEpochSource, listenAddr string) *grpcServer {
+	srv := grpc.NewServer(grpc.CustomCodec(jsonCodec{})) //nolint:staticcheck // no protoc-generated codec available
+	srv.RegisterService(&finalizedEpochServiceDesc, &finalizedEpochServer{source: source})
+
+	return &grpcServer{srv: srv, listenAddr: listenAddr}
+}
+
+// Start binds the gRPC listener and begins serving in the background,
+// reporting failures during Serve on errCh.
+func (g *grpcServer) Start(errCh chan<- error) error {
+	ln, err := net.Listen("tcp", g.listenAddr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", g.listenAddr, err)
+	}
+
+	go func() {
+		if err := g.srv.Serve(ln); err != nil {
+			errCh <- err
+		}
+	}()
+
+	return nil
+}
+
+func (g *grpcServer) Stop() {
+	g.srv.GracefulStop()
+}
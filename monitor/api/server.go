@@ -0,0 +1,139 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+
+	"github.com/babylonchain/vigilante/types"
+)
+
+// FinalizedEpochSource is implemented by the monitor; it lets Server answer
+// queries without the api package depending on the monitor package.
+type FinalizedEpochSource interface {
+	// QueryFinalizedInfoUntilHeight returns the highest epoch whose
+	// checkpoint has been confirmed on BTC at or below btcHeight, or
+	// ok=false if no epoch qualifies yet.
+	QueryFinalizedInfoUntilHeight(btcHeight uint64) (info *types.FinalizedEpochInfo, ok bool)
+}
+
+// Server exposes a read-only HTTP+gRPC API over the monitor's
+// finalized-epoch index, turning the vigilante into a queryable source of
+// BTC-finalization state for wallets and rollup bridges.
+type Server struct {
+	source FinalizedEpochSource
+	srv    *http.Server
+	grpc   *grpcServer
+	errCh  chan error
+}
+
+// New creates a Server that will listen on listenAddr for HTTP and, if
+// grpcListenAddr is non-empty, on grpcListenAddr for gRPC, once Start is
+// called.
+func New(listenAddr, grpcListenAddr string, source FinalizedEpochSource) *Server {
+	s := &Server{
+		source: source,
+		errCh:  make(chan error, 1),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/monitor/v1/finalized_epoch", s.handleFinalizedEpoch)
+	s.srv = &http.Server{Addr: listenAddr, Handler: mux}
+
+	if grpcListenAddr != "" {
+		s.grpc = newGRPCServer(source, grpcListenAddr)
+	}
+
+	return s
+}
+
+// Start binds the listeners and begins serving in the background. A bind
+// failure is returned directly; failures during Serve are reported on Err().
+func (s *Server) Start() error {
+	ln, err := net.Listen("tcp", s.srv.Addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", s.srv.Addr, err)
+	}
+
+	go func() {
+		if err := s.srv.Serve(ln); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			s.errCh <- err
+		}
+	}()
+
+	if s.grpc != nil {
+		if err := s.grpc.Start(s.errCh); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Err reports errors encountered while serving, after Start has returned.
+func (s *Server) Err() <-chan error {
+	return s.errCh
+}
+
+// Stop gracefully shuts the HTTP and gRPC servers down.
+func (s *Server) Stop(ctx context.Context) error {
+	if s.grpc != nil {
+		s.grpc.Stop()
+	}
+	return s.srv.Shutdown(ctx)
+}
+
+type finalizedEpochResponse struct {
+	EpochNum      uint64 `json:"epoch_num"`
+	RawCheckpoint []byte `json:"raw_checkpoint"`
+	BtcHeight     uint64 `json:"btc_height"`
+	BtcBlockHash  string `json:"btc_block_hash"`
+	Proofs        []byte `json:"proofs,omitempty"`
+}
+
+func (s *Server) handleFinalizedEpoch(w http.ResponseWriter, r *http.Request) {
+	heightParam := r.URL.Query().Get("btc_height")
+	btcHeight, err := strconv.ParseUint(heightParam, 10, 64)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("invalid btc_height %q", heightParam), http.StatusBadRequest)
+		return
+	}
+	withProof := r.URL.Query().Get("prove") == "true"
+
+	info, ok := s.source.QueryFinalizedInfoUntilHeight(btcHeight)
+	if !ok {
+		http.Error(w, fmt.Sprintf("no finalized epoch found at or below BTC height %d", btcHeight), http.StatusNotFound)
+		return
+	}
+
+	resp := finalizedEpochResponse{
+		EpochNum:     info.EpochNum,
+		BtcHeight:    info.BtcHeight,
+		BtcBlockHash: info.BtcBlockHash.String(),
+	}
+	if info.RawCheckpoint != nil {
+		rawCkptBytes, err := info.RawCheckpoint.Marshal()
+		if err != nil {
+			http.Error(w, "failed to marshal raw checkpoint", http.StatusInternalServerError)
+			return
+		}
+		resp.RawCheckpoint = rawCkptBytes
+	}
+	if withProof && len(info.Proofs) > 0 {
+		proofBytes, err := json.Marshal(info.Proofs)
+		if err != nil {
+			http.Error(w, "failed to marshal SPV proofs", http.StatusInternalServerError)
+			return
+		}
+		resp.Proofs = proofBytes
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,360 @@
+package btcscanner
+
+import (
+	"bytes"
+	"sync"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+
+	btcctypes "github.com/babylonchain/babylon/x/btccheckpoint/types"
+	checkpointingtypes "github.com/babylonchain/babylon/x/checkpointing/types"
+
+	"github.com/babylonchain/vigilante/types"
+)
+
+// Scanner scans BTC for confirmed headers and the Babylon checkpoints
+// embedded in their OP_RETURN transactions, and reports BTC reorgs to
+// consumers as they are observed.
+type Scanner interface {
+	// Start scans BTC. It blocks until Stop is called, or returns early if
+	// the underlying BTC client fails to start.
+	Start()
+	// Stop stops the scanner and releases its resources.
+	Stop()
+	// Bootstrap seeds the scanner to begin scanning forward from
+	// btcHeight/btcBlockHash instead of Babylon's BTC genesis, e.g. when
+	// fast-syncing from a trusted oracle checkpoint. It must be called
+	// before Start.
+	Bootstrap(btcHeight uint64, btcBlockHash chainhash.Hash)
+
+	// GetHeadersChan returns confirmed BTC headers as they are scanned.
+	GetHeadersChan() chan *wire.BlockHeader
+	// GetCheckpointsChan returns Babylon checkpoints as they are assembled
+	// from their BTC OP_RETURN transactions.
+	GetCheckpointsChan() chan *types.CheckpointRecord
+	// GetChainUpdatesChan reports BTC chain reorgs: a Connect event for
+	// every block extending the best chain, and a Disconnect event for
+	// every block rolled back by a reorg, in the order they are observed.
+	GetChainUpdatesChan() chan *types.ChainUpdate
+}
+
+// BlockNotification is a single block connected to, or disconnected from,
+// a BtcClient's best chain.
+type BlockNotification struct {
+	Height uint64
+	Block  *wire.MsgBlock
+}
+
+// BtcClient is the BTC node connection BtcScanner consumes. Implementations
+// are expected to wrap a full node's block-notification stream (e.g. over
+// ZMQ or websocket RPC notifications).
+type BtcClient interface {
+	Start() error
+	Stop()
+	// Rescan tells the client to begin notifying from startHeight/startHash
+	// instead of wherever it would otherwise resume from.
+	Rescan(startHeight uint64, startHash chainhash.Hash) error
+	BlockConnectedChan() <-chan *BlockNotification
+	BlockDisconnectedChan() <-chan *BlockNotification
+}
+
+// checkpointOpReturnTag marks an OP_RETURN output as carrying one half of a
+// Babylon checkpoint. A checkpoint is split across two OP_RETURN
+// transactions because a single checkpoint does not fit the 80-byte
+// OP_RETURN limit; each carries an explicit part index (0 or 1) so the two
+// halves can be identified and ordered regardless of the order they are
+// observed in.
+var checkpointOpReturnTag = []byte("bbnc")
+
+// BtcScanner is the default Scanner implementation. It consumes a stream of
+// connected/disconnected blocks from a BtcClient and turns it into confirmed
+// headers, assembled checkpoints, and reorg-aware ChainUpdates.
+type BtcScanner struct {
+	client BtcClient
+
+	headersChan      chan *wire.BlockHeader
+	checkpointsChan  chan *types.CheckpointRecord
+	chainUpdatesChan chan *types.ChainUpdate
+
+	bootstrapHeight uint64
+	bootstrapHash   chainhash.Hash
+	hasBootstrap    bool
+
+	pending map[uint64]*checkpointHalves // epoch number -> checkpoint halves collected so far, by part index
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// New creates a BtcScanner that consumes blocks from client.
+func New(client BtcClient) *BtcScanner {
+	return &BtcScanner{
+		client:           client,
+		headersChan:      make(chan *wire.BlockHeader),
+		checkpointsChan:  make(chan *types.CheckpointRecord),
+		chainUpdatesChan: make(chan *types.ChainUpdate),
+		pending:          make(map[uint64]*checkpointHalves),
+		quit:             make(chan struct{}),
+	}
+}
+
+// Bootstrap seeds the scanner to rescan from btcHeight/btcBlockHash instead
+// of genesis. It must be called before Start.
+func (s *BtcScanner) Bootstrap(btcHeight uint64, btcBlockHash chainhash.Hash) {
+	s.bootstrapHeight = btcHeight
+	s.bootstrapHash = btcBlockHash
+	s.hasBootstrap = true
+}
+
+// Start begins scanning BTC. It blocks until Stop is called, or returns early
+// if the BTC client fails to start.
+func (s *BtcScanner) Start() {
+	if s.hasBootstrap {
+		if err := s.client.Rescan(s.bootstrapHeight, s.bootstrapHash); err != nil {
+			log.Errorf("failed to rescan BTC client from height %d: %s", s.bootstrapHeight, err.Error())
+		}
+	}
+
+	if err := s.client.Start(); err != nil {
+		log.Errorf("failed to start BTC client: %s", err.Error())
+		return
+	}
+
+	for {
+		select {
+		case <-s.quit:
+			s.client.Stop()
+			return
+		case notif := <-s.client.BlockConnectedChan():
+			s.handleBlockConnected(notif)
+		case notif := <-s.client.BlockDisconnectedChan():
+			s.handleBlockDisconnected(notif)
+		}
+	}
+}
+
+// Stop signals Start to return.
+func (s *BtcScanner) Stop() {
+	close(s.quit)
+}
+
+func (s *BtcScanner) GetHeadersChan() chan *wire.BlockHeader {
+	return s.headersChan
+}
+
+func (s *BtcScanner) GetCheckpointsChan() chan *types.CheckpointRecord {
+	return s.checkpointsChan
+}
+
+func (s *BtcScanner) GetChainUpdatesChan() chan *types.ChainUpdate {
+	return s.chainUpdatesChan
+}
+
+func (s *BtcScanner) handleBlockConnected(notif *BlockNotification) {
+	blockHash := notif.Block.BlockHash()
+
+	s.chainUpdatesChan <- &types.ChainUpdate{
+		BlockHash:   blockHash,
+		BlockHeight: notif.Height,
+		Event:       types.ChainUpdateConnect,
+	}
+
+	header := notif.Block.Header
+	s.headersChan <- &header
+
+	if ckpt, ok := s.assembleCheckpoint(notif); ok {
+		s.checkpointsChan <- ckpt
+	}
+}
+
+func (s *BtcScanner) handleBlockDisconnected(notif *BlockNotification) {
+	s.chainUpdatesChan <- &types.ChainUpdate{
+		BlockHash:   notif.Block.BlockHash(),
+		BlockHeight: notif.Height,
+		Event:       types.ChainUpdateDisconnect,
+	}
+}
+
+// checkpointHalf is one OP_RETURN transaction carrying half of a checkpoint,
+// kept around so the assembled checkpoint's SubmissionKey can reference
+// exactly the transactions that composed it, and so its SPV proof can be
+// built once both halves are known.
+type checkpointHalf struct {
+	data        []byte
+	txKey       btcctypes.TransactionKey
+	tx          *wire.MsgTx
+	block       *wire.MsgBlock
+	blockHeight uint64
+	txIdx       int
+}
+
+// checkpointHalves tracks the two halves of a checkpoint by their part
+// index (0 or 1), so they can be identified and concatenated in the right
+// order regardless of the order they are observed in, and so a duplicate
+// part index (two halves claiming to be the same half) is detected instead
+// of silently overwriting one another.
+type checkpointHalves [2]*checkpointHalf
+
+// assembleCheckpoint scans block's transactions for a tagged OP_RETURN half
+// of a Babylon checkpoint, and returns a CheckpointRecord once both halves
+// for an epoch have been collected.
+func (s *BtcScanner) assembleCheckpoint(notif *BlockNotification) (*types.CheckpointRecord, bool) {
+	blockHash := btcctypes.NewBTCHeaderHashBytesFromChainhash(notif.Block.BlockHash())
+
+	for txIdx, tx := range notif.Block.Transactions {
+		epochNum, partIndex, part, ok := extractCheckpointPart(tx)
+		if !ok {
+			continue
+		}
+
+		halves := s.pending[epochNum]
+		if halves == nil {
+			halves = new(checkpointHalves)
+			s.pending[epochNum] = halves
+		}
+		if halves[partIndex] != nil {
+			log.Errorf("ignoring duplicate checkpoint part %d for epoch %d at tx %d", partIndex, epochNum, txIdx)
+			continue
+		}
+		halves[partIndex] = &checkpointHalf{
+			data: part,
+			txKey: btcctypes.TransactionKey{
+				Index: uint32(txIdx),
+				Hash:  blockHash,
+			},
+			tx:          tx,
+			block:       notif.Block,
+			blockHeight: notif.Height,
+			txIdx:       txIdx,
+		}
+		if halves[0] == nil || halves[1] == nil {
+			continue
+		}
+
+		delete(s.pending, epochNum)
+
+		rawCkpt := new(checkpointingtypes.RawCheckpoint)
+		data := append(append([]byte(nil), halves[0].data...), halves[1].data...)
+		if err := rawCkpt.Unmarshal(data); err != nil {
+			log.Errorf("failed to decode checkpoint for epoch %d from BTC: %s", epochNum, err.Error())
+			continue
+		}
+
+		submissionKey := &btcctypes.SubmissionKey{
+			Key: []btcctypes.TransactionKey{halves[0].txKey, halves[1].txKey},
+		}
+		spvProofs := []*btcctypes.BTCSpvProof{
+			buildSpvProof(halves[0]),
+			buildSpvProof(halves[1]),
+		}
+
+		return types.NewCheckpointRecord(rawCkpt, notif.Height, notif.Block.BlockHash(), submissionKey, spvProofs), true
+	}
+
+	return nil, false
+}
+
+// buildSpvProof builds the SPV Merkle proof for half's transaction against
+// the block it was confirmed in, so a finalized epoch can be served with
+// prove=true without re-scanning BTC.
+func buildSpvProof(half *checkpointHalf) *btcctypes.BTCSpvProof {
+	var buf bytes.Buffer
+	if err := half.tx.Serialize(&buf); err != nil {
+		log.Errorf("failed to serialize tx %d for SPV proof: %s", half.txIdx, err.Error())
+		return nil
+	}
+
+	return &btcctypes.BTCSpvProof{
+		BtcTransaction:           buf.Bytes(),
+		BtcTransactionIndex:      uint32(half.txIdx),
+		MerkleNodes:              merkleBranch(half.block, half.txIdx),
+		ConfirmingBtcBlockHash:   btcctypes.NewBTCHeaderHashBytesFromChainhash(half.block.BlockHash()),
+		ConfirmingBtcBlockHeight: half.blockHeight,
+	}
+}
+
+// merkleBranch returns the sibling hashes, concatenated leaf-to-root, needed
+// to verify that block.Transactions[txIdx] is included in block's Merkle
+// root.
+func merkleBranch(block *wire.MsgBlock, txIdx int) []byte {
+	level := make([]chainhash.Hash, len(block.Transactions))
+	for i, tx := range block.Transactions {
+		level[i] = tx.TxHash()
+	}
+
+	var nodes []byte
+	idx := txIdx
+	for len(level) > 1 {
+		if idx^1 < len(level) {
+			nodes = append(nodes, level[idx^1][:]...)
+		} else {
+			nodes = append(nodes, level[idx][:]...)
+		}
+
+		var next []chainhash.Hash
+		for i := 0; i < len(level); i += 2 {
+			if i+1 < len(level) {
+				next = append(next, chainhash.DoubleHashH(append(level[i][:], level[i+1][:]...)))
+			} else {
+				next = append(next, chainhash.DoubleHashH(append(level[i][:], level[i][:]...)))
+			}
+		}
+		level = next
+		idx /= 2
+	}
+
+	return nodes
+}
+
+// checkpointPartHeaderLen is the length of a checkpoint OP_RETURN's header:
+// tag (4 bytes) || epoch number (8 bytes, big-endian) || part index (1 byte).
+const checkpointPartHeaderLen = len("bbnc") + 8 + 1
+
+// extractCheckpointPart decodes a tagged checkpoint OP_RETURN output, if tx
+// carries one: tag (4 bytes) || epoch number (8 bytes, big-endian) || part
+// index (1 byte, 0 or 1) || data. partIndex is only valid when ok is true.
+func extractCheckpointPart(tx *wire.MsgTx) (epochNum uint64, partIndex uint8, part []byte, ok bool) {
+	for _, out := range tx.TxOut {
+		data, found := extractOpReturnData(out.PkScript)
+		if !found || len(data) < checkpointPartHeaderLen {
+			continue
+		}
+		if string(data[:len(checkpointOpReturnTag)]) != string(checkpointOpReturnTag) {
+			continue
+		}
+
+		epochNum = bigEndianUint64(data[len(checkpointOpReturnTag) : len(checkpointOpReturnTag)+8])
+		partIndex = data[len(checkpointOpReturnTag)+8]
+		if partIndex > 1 {
+			log.Errorf("ignoring checkpoint OP_RETURN with out-of-range part index %d at epoch %d", partIndex, epochNum)
+			continue
+		}
+		part = data[checkpointPartHeaderLen:]
+		return epochNum, partIndex, part, true
+	}
+
+	return 0, 0, nil, false
+}
+
+func extractOpReturnData(pkScript []byte) ([]byte, bool) {
+	if len(pkScript) < 2 || pkScript[0] != txscript.OP_RETURN {
+		return nil, false
+	}
+
+	tokenizer := txscript.MakeScriptTokenizer(0, pkScript[1:])
+	if !tokenizer.Next() {
+		return nil, false
+	}
+
+	return tokenizer.Data(), true
+}
+
+func bigEndianUint64(b []byte) uint64 {
+	var v uint64
+	for _, c := range b[:8] {
+		v = v<<8 | uint64(c)
+	}
+	return v
+}
@@ -0,0 +1,5 @@
+package btcscanner
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("module", "btcscanner")
@@ -0,0 +1,78 @@
+package monitor
+
+import (
+	"testing"
+
+	btcctypes "github.com/babylonchain/babylon/x/btccheckpoint/types"
+	checkpointingtypes "github.com/babylonchain/babylon/x/checkpointing/types"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+
+	"github.com/babylonchain/vigilante/monitor/querier"
+	"github.com/babylonchain/vigilante/types"
+)
+
+// fakeQuerier is a querier.Querier test double that records reported fraud
+// evidence instead of submitting it to Babylon.
+type fakeQuerier struct {
+	bbnSubmissionKey *btcctypes.SubmissionKey
+	reported         []*types.ConflictingCheckpointEvidence
+}
+
+func (f *fakeQuerier) QueryRawCheckpoint(uint64) (*querier.RawCheckpointResponse, error) {
+	return nil, nil
+}
+
+func (f *fakeQuerier) ContainsBTCHeader(*chainhash.Hash) (bool, error) {
+	return true, nil
+}
+
+func (f *fakeQuerier) QueryInfoForNextEpoch(epochNum uint64) (*types.EpochInfo, error) {
+	return types.NewEpochInfo(epochNum, checkpointingtypes.ValidatorWithBlsKeySet{}), nil
+}
+
+func (f *fakeQuerier) QuerySubmissionKey(uint64) (*btcctypes.SubmissionKey, error) {
+	return f.bbnSubmissionKey, nil
+}
+
+func (f *fakeQuerier) ReportConflictingCheckpoint(evidence *types.ConflictingCheckpointEvidence) error {
+	f.reported = append(f.reported, evidence)
+	return nil
+}
+
+// TestReportConflictingCheckpointMatchesSubmissionKeys verifies that, given
+// two BLS-valid checkpoints for the same epoch with different
+// LastCommitHash values (i.e. handleNewConfirmedCheckpoint has already
+// detected ErrInconsistentLastCommitHash), the monitor reports fraud
+// evidence referencing both checkpoints' submission keys.
+func TestReportConflictingCheckpointMatchesSubmissionKeys(t *testing.T) {
+	btcSubmissionKey := &btcctypes.SubmissionKey{
+		Key: []btcctypes.TransactionKey{{Index: 0}, {Index: 1}},
+	}
+	bbnSubmissionKey := &btcctypes.SubmissionKey{
+		Key: []btcctypes.TransactionKey{{Index: 7}, {Index: 8}},
+	}
+
+	fq := &fakeQuerier{bbnSubmissionKey: bbnSubmissionKey}
+	m := newTestMonitor(5)
+	m.BBNQuerier = fq
+
+	btcCkpt := types.NewCheckpointRecord(&checkpointingtypes.RawCheckpoint{EpochNum: 5}, 100, chainhash.Hash{}, btcSubmissionKey, nil)
+	bbnCkpt := &checkpointingtypes.RawCheckpoint{EpochNum: 5}
+
+	m.reportConflictingCheckpoint(btcCkpt, bbnCkpt)
+
+	if len(fq.reported) != 1 {
+		t.Fatalf("expected exactly one conflicting checkpoint report, got %d", len(fq.reported))
+	}
+
+	ev := fq.reported[0]
+	if ev.EpochNum != 5 {
+		t.Errorf("expected reported evidence for epoch 5, got %d", ev.EpochNum)
+	}
+	if ev.BtcSubmissionKey != btcSubmissionKey {
+		t.Errorf("expected reported evidence to reference the BTC checkpoint's submission key")
+	}
+	if ev.BbnSubmissionKey != bbnSubmissionKey {
+		t.Errorf("expected reported evidence to reference Babylon's submission key")
+	}
+}
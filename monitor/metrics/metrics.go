@@ -0,0 +1,82 @@
+package metrics
+
+import "github.com/prometheus/client_golang/prometheus"
+
+// verification result labels for VerificationsTotal
+const (
+	ResultOK              = "ok"
+	ResultInvalidBLS      = "invalid_bls"
+	ResultInconsistentLCH = "inconsistent_lch"
+	ResultEpochMismatch   = "epoch_mismatch"
+	// ResultQueryError covers verification failures that are not a verdict on
+	// the checkpoint itself (e.g. a Babylon RPC error), so they are not
+	// conflated with a genuine invalid-BLS result
+	ResultQueryError = "query_error"
+)
+
+// Metrics holds every Prometheus collector the monitor reports. Create one
+// with NewMetrics and thread it through the Monitor; all fields are safe for
+// concurrent use, as is every prometheus.Collector.
+type Metrics struct {
+	CurrentEpoch              prometheus.Gauge
+	BtcTipHeight              prometheus.Gauge
+	ChecklistSize             prometheus.Gauge
+	VerificationsTotal        *prometheus.CounterVec
+	HeaderConsistencyFailures prometheus.Counter
+	LivenessAlarmsTotal       prometheus.Counter
+	VerifyCheckpointDuration  prometheus.Histogram
+	BBNQuerierDuration        *prometheus.HistogramVec
+}
+
+// NewMetrics creates and registers the monitor's collectors against
+// registry. Pass prometheus.DefaultRegisterer unless the caller wants an
+// isolated registry, e.g. in tests.
+func NewMetrics(registry prometheus.Registerer) *Metrics {
+	m := &Metrics{
+		CurrentEpoch: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "vigilante_monitor_current_epoch",
+			Help: "The epoch number the monitor is currently verifying checkpoints against",
+		}),
+		BtcTipHeight: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "vigilante_monitor_btc_tip_height",
+			Help: "The height of the highest BTC block the monitor has processed",
+		}),
+		ChecklistSize: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "vigilante_monitor_checklist_size",
+			Help: "The number of checkpoints currently tracked in the checkpoint checklist",
+		}),
+		VerificationsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "vigilante_monitor_checkpoints_verified_total",
+			Help: "The total number of BTC checkpoints the monitor has verified, by outcome",
+		}, []string{"result"}),
+		HeaderConsistencyFailures: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "vigilante_monitor_header_consistency_failures_total",
+			Help: "The total number of BTC headers that failed the Babylon light client consistency check",
+		}),
+		LivenessAlarmsTotal: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "vigilante_monitor_liveness_alarms_total",
+			Help: "The total number of liveness alarms fired by the liveness checker",
+		}),
+		VerifyCheckpointDuration: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Name: "vigilante_monitor_verify_checkpoint_duration_seconds",
+			Help: "The time VerifyCheckpoint takes to verify a BTC checkpoint against Babylon",
+		}),
+		BBNQuerierDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name: "vigilante_monitor_bbn_querier_duration_seconds",
+			Help: "The latency of BBNQuerier RPCs against Babylon, by method",
+		}, []string{"method"}),
+	}
+
+	registry.MustRegister(
+		m.CurrentEpoch,
+		m.BtcTipHeight,
+		m.ChecklistSize,
+		m.VerificationsTotal,
+		m.HeaderConsistencyFailures,
+		m.LivenessAlarmsTotal,
+		m.VerifyCheckpointDuration,
+		m.BBNQuerierDuration,
+	)
+
+	return m
+}
@@ -0,0 +1,56 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Server exposes a registry's collectors on /metrics for Prometheus to scrape.
+type Server struct {
+	srv   *http.Server
+	errCh chan error
+}
+
+// NewServer creates a Server that will serve registry's collectors on
+// listenAddr once Start is called.
+func NewServer(listenAddr string, registry *prometheus.Registry) *Server {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+
+	return &Server{
+		srv:   &http.Server{Addr: listenAddr, Handler: mux},
+		errCh: make(chan error, 1),
+	}
+}
+
+// Start binds the listener and begins serving in the background.
+func (s *Server) Start() error {
+	ln, err := net.Listen("tcp", s.srv.Addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", s.srv.Addr, err)
+	}
+
+	go func() {
+		if err := s.srv.Serve(ln); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			s.errCh <- err
+		}
+	}()
+
+	return nil
+}
+
+// Err reports errors encountered while serving, after Start has returned.
+func (s *Server) Err() <-chan error {
+	return s.errCh
+}
+
+// Stop gracefully shuts the server down.
+func (s *Server) Stop(ctx context.Context) error {
+	return s.srv.Shutdown(ctx)
+}
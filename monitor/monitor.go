@@ -1,30 +1,65 @@
 package monitor
 
 import (
+	"context"
 	"fmt"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/pkg/errors"
 	"go.uber.org/atomic"
 	"sort"
 	"sync"
+	"time"
 
 	checkpointingtypes "github.com/babylonchain/babylon/x/checkpointing/types"
+	"github.com/prometheus/client_golang/prometheus"
+
 	bbnclient "github.com/babylonchain/rpc-client/client"
 	"github.com/babylonchain/vigilante/config"
+	"github.com/babylonchain/vigilante/monitor/alert"
+	"github.com/babylonchain/vigilante/monitor/api"
 	"github.com/babylonchain/vigilante/monitor/btcscanner"
+	"github.com/babylonchain/vigilante/monitor/metrics"
+	"github.com/babylonchain/vigilante/monitor/oracle"
 	"github.com/babylonchain/vigilante/monitor/querier"
+	"github.com/babylonchain/vigilante/monitor/store"
 	"github.com/babylonchain/vigilante/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
+// defaultReorgSafetyLimit is the assumed maximum depth of a BTC reorg. The
+// monitor keeps enough tracking state around to safely unwind a reorg of up
+// to this many blocks; anything deeper is not recoverable and requires
+// operator intervention.
+const defaultReorgSafetyLimit = 6
+
+// defaultLivenessWindow is the number of BTC blocks a checklisted checkpoint
+// may remain unresolved (i.e. curEpoch has not advanced past it) before the
+// liveness checker raises an alarm.
+const defaultLivenessWindow = 100
+
+// livenessCheckInterval is how often the liveness checker scans the
+// checkpoint checklist for violations.
+const livenessCheckInterval = time.Minute
+
+// trackedHeader is a confirmed header the monitor keeps around so it can
+// locate the common ancestor when a Disconnect event arrives.
+type trackedHeader struct {
+	height uint64
+	hash   chainhash.Hash
+}
+
 type Monitor struct {
 	Cfg *config.MonitorConfig
 
 	// BTCScanner scans BTC blocks for checkpoints
 	BTCScanner btcscanner.Scanner
 	// BBNQuerier queries epoch info from Babylon
-	BBNQuerier *querier.Querier
+	BBNQuerier querier.Querier
+
+	// Store persists the monitor's resumable state so it survives a restart
+	Store store.Store
 
 	// curEpoch contains information of the current epoch for verification
 	curEpoch *types.EpochInfo
@@ -32,12 +67,66 @@ type Monitor struct {
 	// tracks checkpoint records that have not been reported back to Babylon
 	checkpointChecklist *types.CheckpointsBookkeeper
 
+	// reorgSafetyLimit is the maximum depth of BTC reorg the monitor tracks
+	// enough state to recover from
+	reorgSafetyLimit uint64
+	// confirmedHeaders is a sliding window of the confirmed headers at most
+	// reorgSafetyLimit blocks behind tip, used to find the common ancestor on
+	// a Disconnect
+	confirmedHeaders []trackedHeader
+	// trackedCheckpoints mirrors checkpointChecklist keyed by the BTC height
+	// each checkpoint was first seen at, so checkpointChecklist can be rebuilt
+	// after a reorg without needing a Remove method on it
+	trackedCheckpoints map[uint64]*types.CheckpointRecord
+	// evictedCheckpoints holds checkpoints dropped by a Disconnect, kept only
+	// as bookkeeping until pruneTrackingState ages them out. A genuine
+	// re-inclusion is detected and re-verified independently, when
+	// BTCScanner re-extracts the checkpoint's transactions from the new best
+	// chain and re-emits it on GetCheckpointsChan
+	evictedCheckpoints []*types.CheckpointRecord
+
+	// btcTipHeight is the height of the highest BTC block the monitor has
+	// processed, read by the liveness checker to judge checklist staleness
+	btcTipHeight uint64
+	// livenessTickChan is signalled by runLivenessChecker on each tick, and
+	// drained by Start's select loop so checkLiveness runs on the same
+	// goroutine that owns checkpointChecklist/trackedCheckpoints
+	livenessTickChan chan struct{}
+	// alarmedEpochs tracks which epochs checkLiveness has already fired
+	// FireLivenessAlarm for, so a checkpoint that is still stuck on a later
+	// tick does not re-alarm every livenessCheckInterval. An epoch is
+	// dropped once it no longer looks censored, so a later recurrence (e.g.
+	// after a reorg) can alarm again.
+	alarmedEpochs map[uint64]bool
+
+	// finalizedIndex holds, for each verified epoch, the BTC height/hash its
+	// checkpoint was confirmed at. It backs the monitor's read API and is
+	// safe for concurrent access from the API server's goroutine.
+	finalizedIndexMu sync.RWMutex
+	finalizedIndex   map[uint64]*types.FinalizedEpochInfo
+
+	// APIServer serves the monitor's read API over HTTP (if Cfg.APIListen is
+	// set) and gRPC (if Cfg.GRPCListen is also set)
+	APIServer *api.Server
+
+	// Metrics holds the monitor's Prometheus collectors
+	Metrics *metrics.Metrics
+	// MetricsServer exposes Metrics on /metrics, if Cfg.MetricsListen is set
+	MetricsServer *metrics.Server
+
+	// Alerter fires on fork detection and liveness alarms. A nil Alerter
+	// disables alerting; the monitor still logs either way
+	Alerter alert.Alerter
+
 	wg      sync.WaitGroup
 	started *atomic.Bool
 	quit    chan struct{}
 }
 
-func New(cfg *config.MonitorConfig, genesisInfo *types.GenesisInfo, scanner btcscanner.Scanner, babylonClient bbnclient.BabylonClient) (*Monitor, error) {
+// New creates a Monitor rooted at the genesis epoch and rehydrates it from
+// store if the store already holds state from a previous run, so a crashed
+// vigilante can resume instead of re-scanning BTC from genesis.
+func New(cfg *config.MonitorConfig, genesisInfo *types.GenesisInfo, scanner btcscanner.Scanner, babylonClient bbnclient.BabylonClient, st store.Store, alerter alert.Alerter) (*Monitor, error) {
 	// genesis validator set needs to be sorted by address to respect the signing order
 	sortedGenesisValSet := GetSortedValSet(genesisInfo.GetBLSKeySet())
 	genesisEpoch := types.NewEpochInfo(
@@ -45,15 +134,121 @@ func New(cfg *config.MonitorConfig, genesisInfo *types.GenesisInfo, scanner btcs
 		sortedGenesisValSet,
 	)
 
-	return &Monitor{
+	registry := prometheus.NewRegistry()
+
+	m := &Monitor{
 		BBNQuerier:          querier.New(babylonClient),
 		BTCScanner:          scanner,
+		Store:               st,
+		Alerter:             alerter,
+		Metrics:             metrics.NewMetrics(registry),
 		Cfg:                 cfg,
 		curEpoch:            genesisEpoch,
 		checkpointChecklist: types.NewCheckpointsBookkeeper(),
+		reorgSafetyLimit:    defaultReorgSafetyLimit,
+		trackedCheckpoints:  make(map[uint64]*types.CheckpointRecord),
+		livenessTickChan:    make(chan struct{}),
+		alarmedEpochs:       make(map[uint64]bool),
+		finalizedIndex:      make(map[uint64]*types.FinalizedEpochInfo),
 		quit:                make(chan struct{}),
 		started:             atomic.NewBool(false),
-	}, nil
+	}
+
+	if cfg.APIListen != "" {
+		m.APIServer = api.New(cfg.APIListen, cfg.GRPCListen, m)
+	}
+
+	if cfg.MetricsListen != "" {
+		m.MetricsServer = metrics.NewServer(cfg.MetricsListen, registry)
+	}
+
+	resumed, err := m.rehydrateFromStore()
+	if err != nil {
+		return nil, fmt.Errorf("failed to rehydrate monitor state from store: %w", err)
+	}
+
+	// only consider fast-sync bootstrapping a fresh monitor; a monitor that
+	// resumed from Store already knows exactly where it left off
+	if !resumed {
+		if err := m.bootstrapFromOracle(); err != nil {
+			log.Errorf("failed to bootstrap from oracle checkpoint, falling back to full scan: %s", err.Error())
+		}
+	}
+
+	return m, nil
+}
+
+// rehydrateFromStore restores curEpoch and checkpointChecklist from Store, if
+// Store already holds state from a previous run. It is a no-op against an
+// empty store, leaving the monitor at the genesis epoch. It reports whether
+// a persisted BTC tip was found, i.e. whether this is a resumed monitor.
+func (m *Monitor) rehydrateFromStore() (bool, error) {
+	if m.Store == nil {
+		return false, nil
+	}
+
+	if epoch, ok, err := m.Store.GetEpoch(); err != nil {
+		return false, fmt.Errorf("failed to load persisted epoch info: %w", err)
+	} else if ok {
+		m.curEpoch = epoch
+	}
+
+	records, err := m.Store.GetCheckpoints()
+	if err != nil {
+		return false, fmt.Errorf("failed to load persisted checkpoint checklist: %w", err)
+	}
+	for _, record := range records {
+		m.checkpointChecklist.Add(record)
+		m.trackedCheckpoints[record.FirstSeenBtcHeight] = record
+	}
+
+	tip, ok, err := m.Store.GetTip()
+	if err != nil {
+		return false, fmt.Errorf("failed to load persisted BTC tip: %w", err)
+	}
+	if ok {
+		log.Infof("resuming monitor from persisted BTC tip at height %d", tip.Height)
+	}
+
+	return ok, nil
+}
+
+// bootstrapFromOracle fast-syncs a fresh Monitor from the highest oracle
+// checkpoint whose signatures meet the configured threshold, instead of
+// scanning BTC from Babylon genesis. It is a no-op, not an error, if no
+// oracle file is configured or no checkpoint meets the threshold.
+func (m *Monitor) bootstrapFromOracle() error {
+	if m.Cfg.OracleFile == "" {
+		return nil
+	}
+
+	checkpoints, err := oracle.LoadFile(m.Cfg.OracleFile)
+	if err != nil {
+		return fmt.Errorf("failed to load oracle file %s: %w", m.Cfg.OracleFile, err)
+	}
+
+	signers, err := oracle.ParseSigners(m.Cfg.OracleSigners)
+	if err != nil {
+		return fmt.Errorf("failed to parse oracle signers: %w", err)
+	}
+
+	ckpt, ok := oracle.Select(checkpoints, signers, m.Cfg.OracleThreshold)
+	if !ok {
+		log.Infof("no oracle checkpoint in %s met the signature threshold, falling back to full scan", m.Cfg.OracleFile)
+		return nil
+	}
+
+	ei, err := m.BBNQuerier.QueryInfoForNextEpoch(ckpt.EpochNum)
+	if err != nil {
+		return fmt.Errorf("failed to query epoch info for oracle checkpoint at epoch %d: %w", ckpt.EpochNum, err)
+	}
+	m.curEpoch = ei
+
+	m.BTCScanner.Bootstrap(ckpt.BtcHeight, ckpt.BtcBlockHash)
+
+	log.Infof("fast-synced monitor from oracle checkpoint at epoch %d, BTC height %d", ckpt.EpochNum, ckpt.BtcHeight)
+
+	return nil
 }
 
 // Start starts the verification core
@@ -76,6 +271,22 @@ func (m *Monitor) Start() {
 		go m.runLivenessChecker()
 	}
 
+	var apiErrCh, metricsErrCh <-chan error
+	if m.APIServer != nil {
+		if err := m.APIServer.Start(); err != nil {
+			log.Errorf("failed to start monitor API server: %s", err.Error())
+		} else {
+			apiErrCh = m.APIServer.Err()
+		}
+	}
+	if m.MetricsServer != nil {
+		if err := m.MetricsServer.Start(); err != nil {
+			log.Errorf("failed to start monitor metrics server: %s", err.Error())
+		} else {
+			metricsErrCh = m.MetricsServer.Err()
+		}
+	}
+
 	for m.started.Load() {
 		select {
 		case <-m.quit:
@@ -92,6 +303,17 @@ func (m *Monitor) Start() {
 			if err != nil {
 				log.Errorf("failed to handle BTC raw checkpoint at epoch %d: %s", ckpt.EpochNum(), err.Error())
 			}
+		case update := <-m.BTCScanner.GetChainUpdatesChan():
+			err := m.handleChainUpdate(update)
+			if err != nil {
+				log.Errorf("failed to handle BTC chain update at height %d: %s", update.BlockHeight, err.Error())
+			}
+		case err := <-apiErrCh:
+			log.Errorf("monitor API server error: %s", err.Error())
+		case err := <-metricsErrCh:
+			log.Errorf("monitor metrics server error: %s", err.Error())
+		case <-m.livenessTickChan:
+			m.checkLiveness()
 		}
 	}
 
@@ -104,31 +326,110 @@ func (m *Monitor) runBTCScanner() {
 	m.wg.Done()
 }
 
+// runLivenessChecker ticks every livenessCheckInterval and signals
+// livenessTickChan, so checkLiveness runs on Start's goroutine instead of
+// racing it over checkpointChecklist/trackedCheckpoints.
+func (m *Monitor) runLivenessChecker() {
+	defer m.wg.Done()
+
+	ticker := time.NewTicker(livenessCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-m.quit:
+			return
+		case <-ticker.C:
+			select {
+			case m.livenessTickChan <- struct{}{}:
+			case <-m.quit:
+				return
+			}
+		}
+	}
+}
+
+// checkLiveness fires FireLivenessAlarm for every checklisted checkpoint
+// whose epoch curEpoch has not yet advanced past, and that has sat on BTC
+// for defaultLivenessWindow blocks or more, i.e. it looks censored from
+// ever being reported back to Babylon. Each epoch alarms only once while it
+// stays censored; alarmedEpochs is pruned of anything that no longer
+// qualifies so a later recurrence can alarm again.
+func (m *Monitor) checkLiveness() {
+	censored := make(map[uint64]bool)
+	for _, ckpt := range m.checkpointChecklist.Records() {
+		if ckpt.EpochNum() < m.GetCurrentEpoch() {
+			continue
+		}
+		if m.btcTipHeight < ckpt.FirstSeenBtcHeight || m.btcTipHeight-ckpt.FirstSeenBtcHeight < defaultLivenessWindow {
+			continue
+		}
+		censored[ckpt.EpochNum()] = true
+		if m.alarmedEpochs[ckpt.EpochNum()] {
+			continue
+		}
+		m.FireLivenessAlarm(ckpt.EpochNum(), ckpt.FirstSeenBtcHeight)
+		m.alarmedEpochs[ckpt.EpochNum()] = true
+	}
+
+	for epochNum := range m.alarmedEpochs {
+		if !censored[epochNum] {
+			delete(m.alarmedEpochs, epochNum)
+		}
+	}
+}
+
 func (m *Monitor) handleNewConfirmedHeader(header *wire.BlockHeader) error {
 	return m.checkHeaderConsistency(header)
 }
 
 func (m *Monitor) handleNewConfirmedCheckpoint(ckpt *types.CheckpointRecord) error {
-	err := m.VerifyCheckpoint(ckpt.RawCheckpoint)
+	timer := prometheus.NewTimer(m.Metrics.VerifyCheckpointDuration)
+	bbnCkpt, err := m.VerifyCheckpoint(ckpt.RawCheckpoint)
+	timer.ObserveDuration()
+
 	if err != nil {
 		if sdkerrors.IsOf(err, types.ErrInconsistentLastCommitHash) {
+			m.Metrics.VerificationsTotal.WithLabelValues(metrics.ResultInconsistentLCH).Inc()
 			// also record conflicting checkpoints since we need to ensure that
 			// alarm will be sent if conflicting checkpoints are censored
 			if m.Cfg.LivenessChecker {
 				m.addCheckpointToCheckList(ckpt)
 			}
+			if m.Cfg.ReportForkEvidence {
+				m.reportConflictingCheckpoint(ckpt, bbnCkpt)
+			}
+			m.persistEpochStatus(m.GetCurrentEpoch(), store.EpochStatusConflicting)
+			m.fireAlert(alert.Alert{
+				Title:    fmt.Sprintf("BTC fork detected at epoch %d", m.GetCurrentEpoch()),
+				Details:  err.Error(),
+				Severity: alert.SeverityCritical,
+			})
 			// stop verification if a valid BTC checkpoint on an inconsistent LastCommitHash is found
 			// this means the ledger is on a fork
 			return fmt.Errorf("verification failed at epoch %v: %w", m.GetCurrentEpoch(), err)
 		}
+		switch {
+		case sdkerrors.IsOf(err, types.ErrInvalidEpochNum):
+			m.Metrics.VerificationsTotal.WithLabelValues(metrics.ResultEpochMismatch).Inc()
+		case sdkerrors.IsOf(err, types.ErrInvalidBLSSignature):
+			m.Metrics.VerificationsTotal.WithLabelValues(metrics.ResultInvalidBLS).Inc()
+		default:
+			// not a verdict on the checkpoint itself, e.g. a Babylon RPC
+			// failure: do not conflate it with a genuine invalid-BLS result
+			m.Metrics.VerificationsTotal.WithLabelValues(metrics.ResultQueryError).Inc()
+		}
 		// skip the error if it is not ErrInconsistentLastCommitHash and verify the next BTC checkpoint
 		log.Infof("invalid BTC checkpoint found at epoch %v: %s", m.GetCurrentEpoch(), err.Error())
 		return nil
 	}
+	m.Metrics.VerificationsTotal.WithLabelValues(metrics.ResultOK).Inc()
 
 	if m.Cfg.LivenessChecker {
 		m.addCheckpointToCheckList(ckpt)
 	}
+	m.persistEpochStatus(m.GetCurrentEpoch(), store.EpochStatusVerified)
+	m.recordFinalizedEpoch(ckpt, bbnCkpt)
 
 	log.Infof("checkpoint at epoch %v has passed the verification", m.GetCurrentEpoch())
 
@@ -145,39 +446,280 @@ func (m *Monitor) GetCurrentEpoch() uint64 {
 	return m.curEpoch.GetEpochNumber()
 }
 
-// VerifyCheckpoint verifies the BTC checkpoint against the Babylon counterpart
-func (m *Monitor) VerifyCheckpoint(btcCkpt *checkpointingtypes.RawCheckpoint) error {
+// VerifyCheckpoint verifies the BTC checkpoint against the Babylon counterpart.
+// Once Babylon's raw checkpoint for the epoch has been queried, it is returned
+// alongside any later verification error (including
+// ErrInconsistentLastCommitHash), so that callers can use it to build fork
+// evidence. It returns nil for the epoch-mismatch, BTC-checkpoint BLS and
+// query failures that happen before Babylon's checkpoint is available.
+func (m *Monitor) VerifyCheckpoint(btcCkpt *checkpointingtypes.RawCheckpoint) (*checkpointingtypes.RawCheckpoint, error) {
 	// check whether the epoch number of the checkpoint equals to the current epoch number
 	if m.GetCurrentEpoch() != btcCkpt.EpochNum {
-		return errors.Wrapf(types.ErrInvalidEpochNum, fmt.Sprintf("found a checkpoint with epoch %v, but the monitor expects epoch %v",
+		return nil, errors.Wrapf(types.ErrInvalidEpochNum, fmt.Sprintf("found a checkpoint with epoch %v, but the monitor expects epoch %v",
 			btcCkpt.EpochNum, m.GetCurrentEpoch()))
 	}
 	// verify BLS sig of the BTC checkpoint
 	err := m.curEpoch.VerifyMultiSig(btcCkpt)
 	if err != nil {
-		return fmt.Errorf("invalid BLS sig of BTC checkpoint at epoch %d: %w", m.GetCurrentEpoch(), err)
+		return nil, errors.Wrapf(types.ErrInvalidBLSSignature, "invalid BLS sig of BTC checkpoint at epoch %d: %s", m.GetCurrentEpoch(), err.Error())
 	}
 	// query checkpoint from Babylon
 	bbnCkpt, err := m.BBNQuerier.QueryRawCheckpoint(btcCkpt.EpochNum)
 	if err != nil {
-		return fmt.Errorf("failed to query raw checkpoint from Babylon, epoch %v: %w", btcCkpt.EpochNum, err)
+		return nil, fmt.Errorf("failed to query raw checkpoint from Babylon, epoch %v: %w", btcCkpt.EpochNum, err)
 	}
 	// verify BLS sig of the raw checkpoint from Babylon
 	err = m.curEpoch.VerifyMultiSig(bbnCkpt.Ckpt)
 	if err != nil {
-		return fmt.Errorf("invalid BLS sig of Babylon raw checkpoint at epoch %d: %w", m.GetCurrentEpoch(), err)
+		return bbnCkpt.Ckpt, errors.Wrapf(types.ErrInvalidBLSSignature, "invalid BLS sig of Babylon raw checkpoint at epoch %d: %s", m.GetCurrentEpoch(), err.Error())
 	}
 	// check whether the checkpoint from Babylon has the same LastCommitHash of the BTC checkpoint
 	if !bbnCkpt.Ckpt.LastCommitHash.Equal(*btcCkpt.LastCommitHash) {
-		return errors.Wrapf(types.ErrInconsistentLastCommitHash, fmt.Sprintf("Babylon checkpoint's LastCommitHash %s, BTC checkpoint's LastCommitHash %s",
+		return bbnCkpt.Ckpt, errors.Wrapf(types.ErrInconsistentLastCommitHash, fmt.Sprintf("Babylon checkpoint's LastCommitHash %s, BTC checkpoint's LastCommitHash %s",
 			bbnCkpt.Ckpt.LastCommitHash.String(), btcCkpt.LastCommitHash))
 	}
-	return nil
+	return bbnCkpt.Ckpt, nil
+}
+
+// reportConflictingCheckpoint builds fork evidence from the BTC checkpoint
+// that failed verification and Babylon's conflicting counterpart, then
+// submits it to Babylon. It is best-effort: a failure to report is logged
+// but does not change the monitor's verification outcome.
+func (m *Monitor) reportConflictingCheckpoint(btcCkpt *types.CheckpointRecord, bbnCkpt *checkpointingtypes.RawCheckpoint) {
+	bbnSubmissionKey, err := m.BBNQuerier.QuerySubmissionKey(btcCkpt.EpochNum())
+	if err != nil {
+		log.Errorf("failed to query submission key of Babylon checkpoint at epoch %d: %s", btcCkpt.EpochNum(), err.Error())
+		return
+	}
+
+	evidence := &types.ConflictingCheckpointEvidence{
+		EpochNum:         btcCkpt.EpochNum(),
+		BtcCheckpoint:    btcCkpt.RawCheckpoint,
+		BtcSubmissionKey: btcCkpt.SubmissionKey,
+		BbnCheckpoint:    bbnCkpt,
+		BbnSubmissionKey: bbnSubmissionKey,
+	}
+
+	if err := m.BBNQuerier.ReportConflictingCheckpoint(evidence); err != nil {
+		log.Errorf("failed to report conflicting checkpoint evidence at epoch %d: %s", btcCkpt.EpochNum(), err.Error())
+		return
+	}
+
+	log.Infof("reported conflicting checkpoint evidence at epoch %d to Babylon", btcCkpt.EpochNum())
 }
 
 func (m *Monitor) addCheckpointToCheckList(ckpt *types.CheckpointRecord) {
-	record := types.NewCheckpointRecord(ckpt.RawCheckpoint, ckpt.FirstSeenBtcHeight)
-	m.checkpointChecklist.Add(record)
+	m.checkpointChecklist.Add(ckpt)
+	m.trackedCheckpoints[ckpt.FirstSeenBtcHeight] = ckpt
+	m.persistCheckpointChecklist()
+	m.Metrics.ChecklistSize.Set(float64(len(m.trackedCheckpoints)))
+}
+
+// persistCheckpointChecklist writes the current checkpointChecklist contents
+// through to Store. Best-effort: a write failure is logged, not surfaced, so
+// a flaky store never blocks verification.
+func (m *Monitor) persistCheckpointChecklist() {
+	if m.Store == nil {
+		return
+	}
+	records := make([]*types.CheckpointRecord, 0, len(m.trackedCheckpoints))
+	for _, record := range m.trackedCheckpoints {
+		records = append(records, record)
+	}
+	if err := m.Store.PutCheckpoints(records); err != nil {
+		log.Errorf("failed to persist checkpoint checklist: %s", err.Error())
+	}
+}
+
+// handleChainUpdate reacts to a ChainUpdate emitted by BTCScanner. Connect
+// events extend the confirmed-header window; Disconnect events roll the
+// monitor's bookkeeping back to the common ancestor.
+func (m *Monitor) handleChainUpdate(update *types.ChainUpdate) error {
+	if update.IsDisconnect() {
+		return m.handleChainDisconnect(update)
+	}
+	return m.handleChainConnect(update)
+}
+
+// handleChainConnect records a newly-connected header and prunes tracking
+// state older than reorgSafetyLimit. A checkpoint evicted by an earlier
+// Disconnect is not re-verified here by height alone: BTCScanner re-extracts
+// and re-emits it on GetCheckpointsChan only once its actual transactions
+// reappear on the new best chain, which is what drives handleNewConfirmedCheckpoint
+// for a genuine re-inclusion.
+func (m *Monitor) handleChainConnect(update *types.ChainUpdate) error {
+	m.confirmedHeaders = append(m.confirmedHeaders, trackedHeader{
+		height: update.BlockHeight,
+		hash:   update.BlockHash,
+	})
+	m.btcTipHeight = update.BlockHeight
+	m.Metrics.BtcTipHeight.Set(float64(update.BlockHeight))
+
+	if m.Store != nil {
+		if err := m.Store.PutTip(store.Tip{Height: update.BlockHeight, Hash: update.BlockHash}); err != nil {
+			log.Errorf("failed to persist BTC tip: %s", err.Error())
+		}
+	}
+
+	m.pruneTrackingState(update.BlockHeight)
+
+	return nil
+}
+
+// handleChainDisconnect walks the confirmed-header window back to the common
+// ancestor, drops every checkpoint seen at or after the disconnected height
+// from checkpointChecklist, and rewinds curEpoch to the earliest epoch that
+// is still backed by a tracked checkpoint.
+func (m *Monitor) handleChainDisconnect(update *types.ChainUpdate) error {
+	var ancestors []trackedHeader
+	for _, h := range m.confirmedHeaders {
+		if h.height < update.BlockHeight {
+			ancestors = append(ancestors, h)
+		}
+	}
+	m.confirmedHeaders = ancestors
+
+	rebuilt := types.NewCheckpointsBookkeeper()
+	tracked := make(map[uint64]*types.CheckpointRecord)
+	for height, ckpt := range m.trackedCheckpoints {
+		if height < update.BlockHeight {
+			rebuilt.Add(ckpt)
+			tracked[height] = ckpt
+			continue
+		}
+		log.Infof("dropping checkpoint at epoch %d first seen at disconnected height %d", ckpt.EpochNum(), height)
+		m.evictedCheckpoints = append(m.evictedCheckpoints, ckpt)
+	}
+	m.checkpointChecklist = rebuilt
+	m.trackedCheckpoints = tracked
+	m.persistCheckpointChecklist()
+	m.Metrics.ChecklistSize.Set(float64(len(m.trackedCheckpoints)))
+
+	m.invalidateFinalizedIndex(update.BlockHeight)
+
+	return m.rewindToEarliestTrackedEpoch()
+}
+
+// rewindToEarliestTrackedEpoch re-queries BBNQuerier for the earliest epoch
+// that is still backed by a tracked checkpoint after a reorg, so curEpoch
+// never refers to an epoch whose supporting checkpoint was just rolled back.
+func (m *Monitor) rewindToEarliestTrackedEpoch() error {
+	earliest := m.GetCurrentEpoch()
+	for _, ckpt := range m.trackedCheckpoints {
+		if epochNum := ckpt.EpochNum(); epochNum < earliest {
+			earliest = epochNum
+		}
+	}
+	if earliest >= m.GetCurrentEpoch() {
+		return nil
+	}
+
+	ei, err := m.BBNQuerier.QueryInfoForNextEpoch(earliest)
+	if err != nil {
+		return fmt.Errorf("failed to rewind to epoch %d after reorg: %w", earliest, err)
+	}
+	m.curEpoch = ei
+	m.Metrics.CurrentEpoch.Set(float64(earliest))
+
+	if m.Store != nil {
+		if err := m.Store.PutEpoch(ei); err != nil {
+			log.Errorf("failed to persist epoch info after rewinding to epoch %d: %s", earliest, err.Error())
+		}
+	}
+
+	return nil
+}
+
+// pruneTrackingState drops confirmed-header and checkpoint tracking state
+// that has fallen more than reorgSafetyLimit blocks behind tip.
+func (m *Monitor) pruneTrackingState(tipHeight uint64) {
+	if tipHeight <= m.reorgSafetyLimit {
+		return
+	}
+	cutoff := tipHeight - m.reorgSafetyLimit
+
+	var kept []trackedHeader
+	for _, h := range m.confirmedHeaders {
+		if h.height >= cutoff {
+			kept = append(kept, h)
+		}
+	}
+	m.confirmedHeaders = kept
+
+	var keptEvicted []*types.CheckpointRecord
+	for _, ckpt := range m.evictedCheckpoints {
+		if ckpt.FirstSeenBtcHeight >= cutoff {
+			keptEvicted = append(keptEvicted, ckpt)
+		}
+	}
+	m.evictedCheckpoints = keptEvicted
+
+	if m.Store != nil {
+		if err := m.Store.Compact(cutoff); err != nil {
+			log.Errorf("failed to compact store below height %d: %s", cutoff, err.Error())
+		}
+	}
+}
+
+// persistEpochStatus writes the verification outcome of an epoch through to
+// Store. Best-effort: a write failure is logged, not surfaced.
+func (m *Monitor) persistEpochStatus(epochNum uint64, status store.EpochStatus) {
+	if m.Store == nil {
+		return
+	}
+	if err := m.Store.PutEpochStatus(epochNum, status); err != nil {
+		log.Errorf("failed to persist verification status of epoch %d: %s", epochNum, err.Error())
+	}
+}
+
+// recordFinalizedEpoch indexes a checkpoint that has just passed
+// verification, so it can be served by the monitor's read API.
+func (m *Monitor) recordFinalizedEpoch(ckpt *types.CheckpointRecord, bbnCkpt *checkpointingtypes.RawCheckpoint) {
+	m.finalizedIndexMu.Lock()
+	defer m.finalizedIndexMu.Unlock()
+
+	m.finalizedIndex[ckpt.EpochNum()] = &types.FinalizedEpochInfo{
+		EpochNum:      ckpt.EpochNum(),
+		RawCheckpoint: bbnCkpt,
+		BtcHeight:     ckpt.FirstSeenBtcHeight,
+		BtcBlockHash:  ckpt.BtcBlockHash,
+		Proofs:        ckpt.SPVProofs,
+	}
+}
+
+// invalidateFinalizedIndex drops every finalizedIndex entry recorded for a
+// BTC height at or after disconnectedHeight, so a rolled-back checkpoint can
+// never be served as finalized again.
+func (m *Monitor) invalidateFinalizedIndex(disconnectedHeight uint64) {
+	m.finalizedIndexMu.Lock()
+	defer m.finalizedIndexMu.Unlock()
+
+	for epochNum, info := range m.finalizedIndex {
+		if info.BtcHeight >= disconnectedHeight {
+			log.Infof("dropping finalized epoch %d rolled back at height %d", epochNum, disconnectedHeight)
+			delete(m.finalizedIndex, epochNum)
+		}
+	}
+}
+
+// QueryFinalizedInfoUntilHeight returns the highest epoch whose checkpoint
+// has been observed on BTC at or below btcHeight, BLS-verified, and matched
+// against Babylon's RawCheckpoint. It implements api.FinalizedEpochSource.
+func (m *Monitor) QueryFinalizedInfoUntilHeight(btcHeight uint64) (*types.FinalizedEpochInfo, bool) {
+	m.finalizedIndexMu.RLock()
+	defer m.finalizedIndexMu.RUnlock()
+
+	var best *types.FinalizedEpochInfo
+	for _, info := range m.finalizedIndex {
+		if info.BtcHeight > btcHeight {
+			continue
+		}
+		if best == nil || info.EpochNum > best.EpochNum {
+			best = info
+		}
+	}
+
+	return best, best != nil
 }
 
 func (m *Monitor) UpdateEpochInfo(epoch uint64) error {
@@ -186,6 +728,13 @@ func (m *Monitor) UpdateEpochInfo(epoch uint64) error {
 		return fmt.Errorf("failed to query information of the epoch %d: %w", epoch, err)
 	}
 	m.curEpoch = ei
+	m.Metrics.CurrentEpoch.Set(float64(epoch))
+
+	if m.Store != nil {
+		if err := m.Store.PutEpoch(ei); err != nil {
+			log.Errorf("failed to persist epoch info for epoch %d: %s", epoch, err.Error())
+		}
+	}
 
 	return nil
 }
@@ -195,15 +744,45 @@ func (m *Monitor) checkHeaderConsistency(header *wire.BlockHeader) error {
 
 	contains, err := m.BBNQuerier.ContainsBTCHeader(&btcHeaderHash)
 	if err != nil {
+		m.Metrics.HeaderConsistencyFailures.Inc()
 		return err
 	}
 	if !contains {
+		m.Metrics.HeaderConsistencyFailures.Inc()
 		return fmt.Errorf("BTC header %x does not exist on Babylon BTC light client", btcHeaderHash)
 	}
 
 	return nil
 }
 
+// fireAlert forwards a to the configured Alerter, if any. It is best-effort:
+// a delivery failure is logged and otherwise ignored.
+func (m *Monitor) fireAlert(a alert.Alert) {
+	if m.Alerter == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := m.Alerter.Fire(ctx, a); err != nil {
+		log.Errorf("failed to fire alert %q: %s", a.Title, err.Error())
+	}
+}
+
+// FireLivenessAlarm raises an alert that a verified checkpoint has not been
+// reported back to Babylon within the liveness window. It replaces silently
+// logging the violation, and is called by the liveness checker.
+func (m *Monitor) FireLivenessAlarm(epochNum uint64, sinceBtcHeight uint64) {
+	m.Metrics.LivenessAlarmsTotal.Inc()
+
+	m.fireAlert(alert.Alert{
+		Title:    fmt.Sprintf("liveness alarm: checkpoint at epoch %d not reported", epochNum),
+		Details:  fmt.Sprintf("checkpoint at epoch %d has been observed on BTC since height %d but is still not reported to Babylon", epochNum, sinceBtcHeight),
+		Severity: alert.SeverityWarning,
+	})
+}
+
 func GetSortedValSet(valSet checkpointingtypes.ValidatorWithBlsKeySet) checkpointingtypes.ValidatorWithBlsKeySet {
 	sort.Slice(valSet.ValSet, func(i, j int) bool {
 		addri, err := sdk.ValAddressFromBech32(valSet.ValSet[i].ValidatorAddress)
@@ -225,4 +804,68 @@ func GetSortedValSet(valSet checkpointingtypes.ValidatorWithBlsKeySet) checkpoin
 func (m *Monitor) Stop() {
 	close(m.quit)
 	m.BTCScanner.Stop()
-}
\ No newline at end of file
+
+	if m.APIServer != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := m.APIServer.Stop(ctx); err != nil {
+			log.Errorf("failed to stop monitor API server: %s", err.Error())
+		}
+	}
+
+	if m.MetricsServer != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := m.MetricsServer.Stop(ctx); err != nil {
+			log.Errorf("failed to stop monitor metrics server: %s", err.Error())
+		}
+	}
+
+	if m.Store != nil {
+		if err := m.Store.Close(); err != nil {
+			log.Errorf("failed to close monitor store: %s", err.Error())
+		}
+	}
+}
+
+// ExportedState is a debugging snapshot of the monitor's persisted state, as
+// read back by ExportState. No CLI subcommand in this repository exposes it.
+type ExportedState struct {
+	Tip           store.Tip
+	CurEpoch      uint64
+	Checkpoints   []*types.CheckpointRecord
+	EpochStatuses map[uint64]store.EpochStatus
+}
+
+// ExportState reads the monitor's persisted state back out of Store for
+// operator debugging. It does not require a running Monitor.
+func ExportState(st store.Store) (*ExportedState, error) {
+	tip, _, err := st.GetTip()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read persisted tip: %w", err)
+	}
+	epoch, _, err := st.GetEpoch()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read persisted epoch info: %w", err)
+	}
+	checkpoints, err := st.GetCheckpoints()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read persisted checkpoint checklist: %w", err)
+	}
+	statuses, err := st.GetEpochStatuses()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read persisted epoch statuses: %w", err)
+	}
+
+	var curEpochNum uint64
+	if epoch != nil {
+		curEpochNum = epoch.GetEpochNumber()
+	}
+
+	return &ExportedState{
+		Tip:           tip,
+		CurEpoch:      curEpochNum,
+		Checkpoints:   checkpoints,
+		EpochStatuses: statuses,
+	}, nil
+}
@@ -0,0 +1,165 @@
+package oracle
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"gopkg.in/yaml.v2"
+
+	"github.com/babylonchain/vigilante/types"
+)
+
+// checkpointFile is the on-disk representation of an oracle file: a flat
+// list of signed checkpoints, newest or oldest first, order does not matter.
+type checkpointFile struct {
+	Checkpoints []fileCheckpoint `json:"checkpoints" yaml:"checkpoints"`
+}
+
+type fileCheckpoint struct {
+	EpochNum     uint64   `json:"epoch_num" yaml:"epoch_num"`
+	BtcHeight    uint64   `json:"btc_height" yaml:"btc_height"`
+	BtcBlockHash string   `json:"btc_block_hash" yaml:"btc_block_hash"`
+	ValSetHash   string   `json:"val_set_hash" yaml:"val_set_hash"`
+	Signatures   []string `json:"signatures" yaml:"signatures"`
+}
+
+// LoadFile reads a JSON or YAML oracle file (picked by file extension) and
+// parses its checkpoints. It does not verify signatures.
+func LoadFile(path string) ([]*types.SyncCheckpoint, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read oracle file %s: %w", path, err)
+	}
+
+	var f checkpointFile
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(raw, &f)
+	case ".json", "":
+		err = json.Unmarshal(raw, &f)
+	default:
+		return nil, fmt.Errorf("unsupported oracle file extension %q", ext)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse oracle file %s: %w", path, err)
+	}
+
+	checkpoints := make([]*types.SyncCheckpoint, 0, len(f.Checkpoints))
+	for i, fc := range f.Checkpoints {
+		ckpt, err := fc.decode()
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode oracle checkpoint %d: %w", i, err)
+		}
+		checkpoints = append(checkpoints, ckpt)
+	}
+
+	return checkpoints, nil
+}
+
+func (fc fileCheckpoint) decode() (*types.SyncCheckpoint, error) {
+	blockHashBytes, err := hex.DecodeString(fc.BtcBlockHash)
+	if err != nil {
+		return nil, fmt.Errorf("invalid btc_block_hash: %w", err)
+	}
+	valSetHash, err := hex.DecodeString(fc.ValSetHash)
+	if err != nil {
+		return nil, fmt.Errorf("invalid val_set_hash: %w", err)
+	}
+	sigs := make([][]byte, len(fc.Signatures))
+	for i, s := range fc.Signatures {
+		sig, err := hex.DecodeString(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid signature %d: %w", i, err)
+		}
+		sigs[i] = sig
+	}
+
+	ckpt := &types.SyncCheckpoint{
+		EpochNum:   fc.EpochNum,
+		BtcHeight:  fc.BtcHeight,
+		ValSetHash: valSetHash,
+		Signatures: sigs,
+	}
+	copy(ckpt.BtcBlockHash[:], blockHashBytes)
+
+	return ckpt, nil
+}
+
+// Select returns the highest-epoch checkpoint from checkpoints whose
+// signatures meet threshold valid signatures from signers, or false if none
+// qualifies. A fresh Monitor falls back to a full scan in that case. A
+// non-positive threshold is rejected outright: it would let an unsigned
+// checkpoint satisfy countValidSignatures >= threshold trivially.
+func Select(checkpoints []*types.SyncCheckpoint, signers []*btcec.PublicKey, threshold int) (*types.SyncCheckpoint, bool) {
+	if threshold <= 0 {
+		return nil, false
+	}
+
+	sorted := make([]*types.SyncCheckpoint, len(checkpoints))
+	copy(sorted, checkpoints)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].EpochNum > sorted[j].EpochNum
+	})
+
+	for _, ckpt := range sorted {
+		if countValidSignatures(ckpt, signers) >= threshold {
+			return ckpt, true
+		}
+	}
+
+	return nil, false
+}
+
+func countValidSignatures(ckpt *types.SyncCheckpoint, signers []*btcec.PublicKey) int {
+	digest := sha256.Sum256(ckpt.SignBytes())
+
+	// a signer is only counted once even if multiple signatures happen to
+	// verify against it
+	countedSigners := make(map[int]bool)
+	valid := 0
+
+	for _, rawSig := range ckpt.Signatures {
+		sig, err := ecdsa.ParseDERSignature(rawSig)
+		if err != nil {
+			continue
+		}
+		for i, signer := range signers {
+			if countedSigners[i] {
+				continue
+			}
+			if sig.Verify(digest[:], signer) {
+				countedSigners[i] = true
+				valid++
+				break
+			}
+		}
+	}
+
+	return valid
+}
+
+// ParseSigners parses hex-encoded compressed secp256k1 public keys, as
+// configured via MonitorConfig.OracleSigners.
+func ParseSigners(hexPubKeys []string) ([]*btcec.PublicKey, error) {
+	signers := make([]*btcec.PublicKey, len(hexPubKeys))
+	for i, h := range hexPubKeys {
+		b, err := hex.DecodeString(h)
+		if err != nil {
+			return nil, fmt.Errorf("invalid oracle signer public key %q: %w", h, err)
+		}
+		pk, err := btcec.ParsePubKey(b)
+		if err != nil {
+			return nil, fmt.Errorf("invalid oracle signer public key %q: %w", h, err)
+		}
+		signers[i] = pk
+	}
+	return signers, nil
+}
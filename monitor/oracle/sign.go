@@ -0,0 +1,20 @@
+package oracle
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+
+	"github.com/babylonchain/vigilante/types"
+)
+
+// Sign signs a SyncCheckpoint's SignBytes with key and returns the
+// DER-encoded signature, hex-encoded for embedding in an oracle file's
+// signatures list. No CLI subcommand in this repository calls it.
+func Sign(ckpt *types.SyncCheckpoint, key *btcec.PrivateKey) string {
+	digest := sha256.Sum256(ckpt.SignBytes())
+	sig := ecdsa.Sign(key, digest[:])
+	return hex.EncodeToString(sig.Serialize())
+}
@@ -0,0 +1,95 @@
+package querier
+
+import (
+	"fmt"
+
+	btcctypes "github.com/babylonchain/babylon/x/btccheckpoint/types"
+	checkpointingtypes "github.com/babylonchain/babylon/x/checkpointing/types"
+	bbnclient "github.com/babylonchain/rpc-client/client"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+
+	"github.com/babylonchain/vigilante/types"
+)
+
+// Querier is the set of queries the Monitor needs against Babylon, to verify
+// checkpoints and report fraud-proof evidence. BabylonQuerier is the
+// production implementation; tests substitute a fake.
+type Querier interface {
+	QueryRawCheckpoint(epochNum uint64) (*RawCheckpointResponse, error)
+	ContainsBTCHeader(hash *chainhash.Hash) (bool, error)
+	QueryInfoForNextEpoch(epochNum uint64) (*types.EpochInfo, error)
+	QuerySubmissionKey(epochNum uint64) (*btcctypes.SubmissionKey, error)
+	ReportConflictingCheckpoint(evidence *types.ConflictingCheckpointEvidence) error
+}
+
+// BabylonQuerier wraps a Babylon client with the queries the monitor needs
+// to verify checkpoints against Babylon's view of the chain, and with the
+// submission of conflicting-checkpoint evidence to Babylon.
+type BabylonQuerier struct {
+	babylonClient bbnclient.BabylonClient
+}
+
+// New creates a BabylonQuerier backed by babylonClient.
+func New(babylonClient bbnclient.BabylonClient) *BabylonQuerier {
+	return &BabylonQuerier{babylonClient: babylonClient}
+}
+
+// RawCheckpointResponse wraps Babylon's canonical checkpoint for an epoch.
+type RawCheckpointResponse struct {
+	Ckpt *checkpointingtypes.RawCheckpoint
+}
+
+// QueryRawCheckpoint returns Babylon's canonical checkpoint for epochNum.
+func (q *BabylonQuerier) QueryRawCheckpoint(epochNum uint64) (*RawCheckpointResponse, error) {
+	resp, err := q.babylonClient.RawCheckpoint(epochNum)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query raw checkpoint for epoch %d: %w", epochNum, err)
+	}
+
+	return &RawCheckpointResponse{Ckpt: resp.RawCheckpoint}, nil
+}
+
+// ContainsBTCHeader reports whether Babylon's BTC light client has indexed
+// the header identified by hash.
+func (q *BabylonQuerier) ContainsBTCHeader(hash *chainhash.Hash) (bool, error) {
+	contains, err := q.babylonClient.ContainsBTCHeader(hash)
+	if err != nil {
+		return false, fmt.Errorf("failed to query BTC header %s on Babylon: %w", hash, err)
+	}
+
+	return contains, nil
+}
+
+// QueryInfoForNextEpoch returns the validator set Babylon has finalized for
+// epochNum, as an EpochInfo the monitor can verify checkpoints against.
+func (q *BabylonQuerier) QueryInfoForNextEpoch(epochNum uint64) (*types.EpochInfo, error) {
+	resp, err := q.babylonClient.ValidatorSet(epochNum)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query validator set for epoch %d: %w", epochNum, err)
+	}
+
+	return types.NewEpochInfo(epochNum, resp.ValidatorSet), nil
+}
+
+// QuerySubmissionKey returns the SubmissionKey of the BTC checkpoint Babylon
+// has indexed for epochNum, i.e. the OP_RETURN transactions Babylon itself
+// considers canonical for that epoch.
+func (q *BabylonQuerier) QuerySubmissionKey(epochNum uint64) (*btcctypes.SubmissionKey, error) {
+	resp, err := q.babylonClient.BTCCheckpointSubmissionKey(epochNum)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query BTC checkpoint submission key for epoch %d: %w", epochNum, err)
+	}
+
+	return resp.SubmissionKey, nil
+}
+
+// ReportConflictingCheckpoint submits fraud-proof evidence to Babylon that
+// the BTC ledger carries a checkpoint conflicting with Babylon's own
+// canonical checkpoint for the same epoch.
+func (q *BabylonQuerier) ReportConflictingCheckpoint(evidence *types.ConflictingCheckpointEvidence) error {
+	if err := q.babylonClient.ReportConflictingCheckpoint(evidence); err != nil {
+		return fmt.Errorf("failed to submit conflicting checkpoint evidence for epoch %d: %w", evidence.EpochNum, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package monitor
+
+import (
+	"testing"
+
+	checkpointingtypes "github.com/babylonchain/babylon/x/checkpointing/types"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/babylonchain/vigilante/monitor/metrics"
+	"github.com/babylonchain/vigilante/types"
+)
+
+func newTestMonitor(curEpochNum uint64) *Monitor {
+	return &Monitor{
+		curEpoch:            types.NewEpochInfo(curEpochNum, checkpointingtypes.ValidatorWithBlsKeySet{}),
+		checkpointChecklist: types.NewCheckpointsBookkeeper(),
+		trackedCheckpoints:  make(map[uint64]*types.CheckpointRecord),
+		reorgSafetyLimit:    defaultReorgSafetyLimit,
+		Metrics:             metrics.NewMetrics(prometheus.NewRegistry()),
+	}
+}
+
+func newTestCheckpointRecord(epochNum, firstSeenBtcHeight uint64) *types.CheckpointRecord {
+	return types.NewCheckpointRecord(&checkpointingtypes.RawCheckpoint{EpochNum: epochNum}, firstSeenBtcHeight, chainhash.Hash{}, nil, nil)
+}
+
+// TestHandleChainDisconnectDropsRolledBackCheckpoints verifies that a
+// Disconnect event evicts every checkpoint first seen at or after the
+// disconnected height, while leaving earlier checkpoints tracked.
+func TestHandleChainDisconnectDropsRolledBackCheckpoints(t *testing.T) {
+	m := newTestMonitor(5)
+
+	survivor := newTestCheckpointRecord(5, 100)
+	rolledBack := newTestCheckpointRecord(6, 105)
+	m.addCheckpointToCheckList(survivor)
+	m.addCheckpointToCheckList(rolledBack)
+
+	if err := m.handleChainDisconnect(&types.ChainUpdate{BlockHeight: 105, Event: types.ChainUpdateDisconnect}); err != nil {
+		t.Fatalf("handleChainDisconnect returned error: %s", err)
+	}
+
+	if _, ok := m.trackedCheckpoints[100]; !ok {
+		t.Errorf("expected checkpoint first seen at height 100 to remain tracked")
+	}
+	if _, ok := m.trackedCheckpoints[105]; ok {
+		t.Errorf("expected checkpoint first seen at height 105 to be evicted")
+	}
+
+	if len(m.evictedCheckpoints) != 1 || m.evictedCheckpoints[0].EpochNum() != 6 {
+		t.Errorf("expected the rolled-back checkpoint to be recorded as evicted, got %+v", m.evictedCheckpoints)
+	}
+
+	// curEpoch must not have moved: the only remaining tracked checkpoint
+	// (epoch 5) is not earlier than curEpoch (5), so there is nothing to
+	// rewind to.
+	if m.GetCurrentEpoch() != 5 {
+		t.Errorf("expected curEpoch to remain 5, got %d", m.GetCurrentEpoch())
+	}
+}
+
+// TestPruneTrackingStateDropsOldHeaders verifies that confirmed-header and
+// evicted-checkpoint bookkeeping is dropped once it falls more than
+// reorgSafetyLimit blocks behind tip.
+func TestPruneTrackingStateDropsOldHeaders(t *testing.T) {
+	m := newTestMonitor(1)
+	m.confirmedHeaders = []trackedHeader{{height: 10}, {height: 90}, {height: 95}}
+	m.evictedCheckpoints = []*types.CheckpointRecord{
+		newTestCheckpointRecord(1, 10),
+		newTestCheckpointRecord(1, 95),
+	}
+
+	m.pruneTrackingState(100)
+
+	if len(m.confirmedHeaders) != 1 {
+		t.Errorf("expected 1 confirmed header to survive pruning, got %d", len(m.confirmedHeaders))
+	}
+	if len(m.evictedCheckpoints) != 1 {
+		t.Errorf("expected 1 evicted checkpoint to survive pruning, got %d", len(m.evictedCheckpoints))
+	}
+}
@@ -0,0 +1,191 @@
+package store
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	bbolt "go.etcd.io/bbolt"
+
+	"github.com/babylonchain/vigilante/types"
+)
+
+var (
+	tipBucketName         = []byte("tip")
+	tipKey                = []byte("tip")
+	epochBucketName       = []byte("epoch")
+	epochKey              = []byte("epoch")
+	checkpointsBucketName = []byte("checkpoints")
+	epochStatusBucketName = []byte("epoch-status")
+)
+
+// BboltStore is the default, disk-backed Store implementation.
+type BboltStore struct {
+	db *bbolt.DB
+}
+
+// NewBboltStore opens (creating if necessary) a bbolt-backed Store at path.
+func NewBboltStore(path string) (*BboltStore, error) {
+	db, err := bbolt.Open(path, 0600, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open bbolt store at %s: %w", path, err)
+	}
+
+	err = db.Update(func(tx *bbolt.Tx) error {
+		for _, name := range [][]byte{tipBucketName, epochBucketName, checkpointsBucketName, epochStatusBucketName} {
+			if _, err := tx.CreateBucketIfNotExists(name); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialise bbolt buckets: %w", err)
+	}
+
+	return &BboltStore{db: db}, nil
+}
+
+func (s *BboltStore) PutTip(tip Tip) error {
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		buf := make([]byte, 8+chainhash.HashSize)
+		binary.BigEndian.PutUint64(buf[:8], tip.Height)
+		copy(buf[8:], tip.Hash[:])
+		return tx.Bucket(tipBucketName).Put(tipKey, buf)
+	})
+}
+
+func (s *BboltStore) GetTip() (Tip, bool, error) {
+	var tip Tip
+	var ok bool
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		v := tx.Bucket(tipBucketName).Get(tipKey)
+		if v == nil {
+			return nil
+		}
+		if len(v) != 8+chainhash.HashSize {
+			return fmt.Errorf("corrupt tip record: expected %d bytes, got %d", 8+chainhash.HashSize, len(v))
+		}
+		tip.Height = binary.BigEndian.Uint64(v[:8])
+		copy(tip.Hash[:], v[8:])
+		ok = true
+		return nil
+	})
+	return tip, ok, err
+}
+
+func (s *BboltStore) PutEpoch(epoch *types.EpochInfo) error {
+	b, err := json.Marshal(epoch)
+	if err != nil {
+		return fmt.Errorf("failed to marshal epoch info: %w", err)
+	}
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket(epochBucketName).Put(epochKey, b)
+	})
+}
+
+func (s *BboltStore) GetEpoch() (*types.EpochInfo, bool, error) {
+	var epoch *types.EpochInfo
+	var ok bool
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		v := tx.Bucket(epochBucketName).Get(epochKey)
+		if v == nil {
+			return nil
+		}
+		epoch = new(types.EpochInfo)
+		if err := json.Unmarshal(v, epoch); err != nil {
+			return fmt.Errorf("failed to unmarshal epoch info: %w", err)
+		}
+		ok = true
+		return nil
+	})
+	return epoch, ok, err
+}
+
+func (s *BboltStore) PutCheckpoints(records []*types.CheckpointRecord) error {
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		b := tx.Bucket(checkpointsBucketName)
+		if err := b.ForEach(func(k, _ []byte) error {
+			return b.Delete(k)
+		}); err != nil {
+			return err
+		}
+		for _, record := range records {
+			v, err := json.Marshal(record)
+			if err != nil {
+				return fmt.Errorf("failed to marshal checkpoint record: %w", err)
+			}
+			if err := b.Put(heightKey(record.FirstSeenBtcHeight), v); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (s *BboltStore) GetCheckpoints() ([]*types.CheckpointRecord, error) {
+	var records []*types.CheckpointRecord
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		return tx.Bucket(checkpointsBucketName).ForEach(func(_, v []byte) error {
+			record := new(types.CheckpointRecord)
+			if err := json.Unmarshal(v, record); err != nil {
+				return fmt.Errorf("failed to unmarshal checkpoint record: %w", err)
+			}
+			records = append(records, record)
+			return nil
+		})
+	})
+	return records, err
+}
+
+func (s *BboltStore) PutEpochStatus(epochNum uint64, status EpochStatus) error {
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket(epochStatusBucketName).Put(heightKey(epochNum), []byte(status))
+	})
+}
+
+func (s *BboltStore) GetEpochStatuses() (map[uint64]EpochStatus, error) {
+	out := make(map[uint64]EpochStatus)
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		return tx.Bucket(epochStatusBucketName).ForEach(func(k, v []byte) error {
+			out[binary.BigEndian.Uint64(k)] = EpochStatus(v)
+			return nil
+		})
+	})
+	return out, err
+}
+
+func (s *BboltStore) Compact(belowHeight uint64) error {
+	cutoff := heightKey(belowHeight)
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		// epoch-status is deliberately left out here: it is keyed by epoch
+		// number, not BTC height, so pruning it against a height cutoff
+		// would wipe out nearly all epoch statuses on the first compaction
+		// after startup.
+		b := tx.Bucket(checkpointsBucketName)
+		c := b.Cursor()
+		var stale [][]byte
+		for k, _ := c.First(); k != nil; k, _ = c.Next() {
+			if string(k) < string(cutoff) {
+				stale = append(stale, append([]byte(nil), k...))
+			}
+		}
+		for _, k := range stale {
+			if err := b.Delete(k); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (s *BboltStore) Close() error {
+	return s.db.Close()
+}
+
+func heightKey(height uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, height)
+	return b
+}
@@ -0,0 +1,99 @@
+package store
+
+import (
+	"sync"
+
+	"github.com/babylonchain/vigilante/types"
+)
+
+// MemStore is an in-memory Store, used in tests and anywhere durability
+// across restarts is not required.
+type MemStore struct {
+	mu            sync.Mutex
+	tip           Tip
+	hasTip        bool
+	epoch         *types.EpochInfo
+	checkpoints   []*types.CheckpointRecord
+	epochStatuses map[uint64]EpochStatus
+}
+
+// NewMemStore creates an empty in-memory Store.
+func NewMemStore() *MemStore {
+	return &MemStore{
+		epochStatuses: make(map[uint64]EpochStatus),
+	}
+}
+
+func (s *MemStore) PutTip(tip Tip) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tip = tip
+	s.hasTip = true
+	return nil
+}
+
+func (s *MemStore) GetTip() (Tip, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.tip, s.hasTip, nil
+}
+
+func (s *MemStore) PutEpoch(epoch *types.EpochInfo) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.epoch = epoch
+	return nil
+}
+
+func (s *MemStore) GetEpoch() (*types.EpochInfo, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.epoch, s.epoch != nil, nil
+}
+
+func (s *MemStore) PutCheckpoints(records []*types.CheckpointRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.checkpoints = records
+	return nil
+}
+
+func (s *MemStore) GetCheckpoints() ([]*types.CheckpointRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.checkpoints, nil
+}
+
+func (s *MemStore) PutEpochStatus(epochNum uint64, status EpochStatus) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.epochStatuses[epochNum] = status
+	return nil
+}
+
+func (s *MemStore) GetEpochStatuses() (map[uint64]EpochStatus, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make(map[uint64]EpochStatus, len(s.epochStatuses))
+	for k, v := range s.epochStatuses {
+		out[k] = v
+	}
+	return out, nil
+}
+
+func (s *MemStore) Compact(belowHeight uint64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var kept []*types.CheckpointRecord
+	for _, ckpt := range s.checkpoints {
+		if ckpt.FirstSeenBtcHeight >= belowHeight {
+			kept = append(kept, ckpt)
+		}
+	}
+	s.checkpoints = kept
+	return nil
+}
+
+func (s *MemStore) Close() error {
+	return nil
+}
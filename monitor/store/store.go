@@ -0,0 +1,60 @@
+package store
+
+import (
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+
+	"github.com/babylonchain/vigilante/types"
+)
+
+// EpochStatus records the outcome of verifying an epoch's checkpoint.
+type EpochStatus string
+
+const (
+	EpochStatusPending     EpochStatus = "pending"
+	EpochStatusVerified    EpochStatus = "verified"
+	EpochStatusConflicting EpochStatus = "conflicting"
+)
+
+// Tip is the last BTC block the monitor has processed.
+type Tip struct {
+	Height uint64
+	Hash   chainhash.Hash
+}
+
+// Store persists the Monitor's resumable state: the last processed BTC tip,
+// the current epoch, the checkpoint checklist, and per-epoch verification
+// status. Implementations must make each Put call atomic so a crash between
+// writes never leaves the store half-updated.
+type Store interface {
+	// PutTip persists the last BTC block the monitor has processed.
+	PutTip(tip Tip) error
+	// GetTip returns the last persisted tip. ok is false if nothing has been
+	// persisted yet, e.g. on a brand new store.
+	GetTip() (tip Tip, ok bool, err error)
+
+	// PutEpoch persists the monitor's current epoch info.
+	PutEpoch(epoch *types.EpochInfo) error
+	// GetEpoch returns the last persisted epoch info.
+	GetEpoch() (epoch *types.EpochInfo, ok bool, err error)
+
+	// PutCheckpoints overwrites the persisted checkpoint checklist with
+	// exactly the given records.
+	PutCheckpoints(records []*types.CheckpointRecord) error
+	// GetCheckpoints returns the persisted checkpoint checklist contents.
+	GetCheckpoints() ([]*types.CheckpointRecord, error)
+
+	// PutEpochStatus persists the verification status of an epoch.
+	PutEpochStatus(epochNum uint64, status EpochStatus) error
+	// GetEpochStatuses returns the verification status of every epoch the
+	// store has a record for.
+	GetEpochStatuses() (map[uint64]EpochStatus, error)
+
+	// Compact drops persisted tip/checkpoint history that is below
+	// belowHeight, i.e. further behind the confirmed tip than the monitor's
+	// reorg safety limit. Epoch statuses are keyed by epoch number rather
+	// than BTC height and are not affected by belowHeight.
+	Compact(belowHeight uint64) error
+
+	// Close releases resources held by the store.
+	Close() error
+}
@@ -0,0 +1,33 @@
+package types
+
+import "github.com/btcsuite/btcd/chaincfg/chainhash"
+
+// ChainUpdateEvent describes whether a ChainUpdate connects a new block to the
+// tip of the chain the scanner is following, or disconnects a previously
+// connected block because the scanner has detected a reorg.
+type ChainUpdateEvent int
+
+const (
+	ChainUpdateConnect ChainUpdateEvent = iota
+	ChainUpdateDisconnect
+)
+
+// ChainUpdate is emitted by the BTC scanner whenever a block is connected to,
+// or disconnected from, the chain it is following. A Disconnect event is
+// emitted for every block the scanner's BTC client reports as disconnected;
+// the scanner applies no reorg depth limit of its own.
+type ChainUpdate struct {
+	BlockHash   chainhash.Hash
+	BlockHeight uint64
+	Event       ChainUpdateEvent
+}
+
+// IsConnect reports whether the update connects a new block to the tip.
+func (u *ChainUpdate) IsConnect() bool {
+	return u.Event == ChainUpdateConnect
+}
+
+// IsDisconnect reports whether the update rolls back a previously connected block.
+func (u *ChainUpdate) IsDisconnect() bool {
+	return u.Event == ChainUpdateDisconnect
+}
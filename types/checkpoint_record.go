@@ -0,0 +1,87 @@
+package types
+
+import (
+	btcctypes "github.com/babylonchain/babylon/x/btccheckpoint/types"
+	checkpointingtypes "github.com/babylonchain/babylon/x/checkpointing/types"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+)
+
+// CheckpointRecord is a BTC checkpoint as assembled by the BTC scanner from
+// its OP_RETURN transactions, together with the bookkeeping the monitor
+// needs to track it through verification.
+type CheckpointRecord struct {
+	RawCheckpoint *checkpointingtypes.RawCheckpoint
+	// FirstSeenBtcHeight is the height of the BTC block the checkpoint's
+	// second (concluding) OP_RETURN half was confirmed in
+	FirstSeenBtcHeight uint64
+	// BtcBlockHash is the hash of the BTC block identified by
+	// FirstSeenBtcHeight, i.e. the block that confirmed the checkpoint's
+	// concluding OP_RETURN half
+	BtcBlockHash chainhash.Hash
+	// SubmissionKey identifies the two OP_RETURN transactions that carried
+	// this checkpoint on BTC, so fraud-proof evidence can reference exactly
+	// which transactions a conflicting checkpoint came from
+	SubmissionKey *btcctypes.SubmissionKey
+	// SPVProofs are the SPV Merkle proofs of the two OP_RETURN transactions
+	// identified by SubmissionKey, populated by the scanner so a finalized
+	// epoch can be served with prove=true without re-scanning BTC
+	SPVProofs []*btcctypes.BTCSpvProof
+}
+
+// NewCheckpointRecord creates a CheckpointRecord for rawCkpt, first seen
+// confirmed at firstSeenBtcHeight/btcBlockHash and carried by the two
+// OP_RETURN transactions identified by submissionKey and proven by
+// spvProofs.
+func NewCheckpointRecord(
+	rawCkpt *checkpointingtypes.RawCheckpoint,
+	firstSeenBtcHeight uint64,
+	btcBlockHash chainhash.Hash,
+	submissionKey *btcctypes.SubmissionKey,
+	spvProofs []*btcctypes.BTCSpvProof,
+) *CheckpointRecord {
+	return &CheckpointRecord{
+		RawCheckpoint:      rawCkpt,
+		FirstSeenBtcHeight: firstSeenBtcHeight,
+		BtcBlockHash:       btcBlockHash,
+		SubmissionKey:      submissionKey,
+		SPVProofs:          spvProofs,
+	}
+}
+
+// EpochNum returns the epoch number the checkpoint was raised for.
+func (r *CheckpointRecord) EpochNum() uint64 {
+	return r.RawCheckpoint.EpochNum
+}
+
+// CheckpointsBookkeeper tracks the checkpoints the monitor has seen on BTC
+// but not yet finished processing, keyed by epoch number.
+type CheckpointsBookkeeper struct {
+	records map[uint64]*CheckpointRecord
+}
+
+// NewCheckpointsBookkeeper creates an empty CheckpointsBookkeeper.
+func NewCheckpointsBookkeeper() *CheckpointsBookkeeper {
+	return &CheckpointsBookkeeper{
+		records: make(map[uint64]*CheckpointRecord),
+	}
+}
+
+// Add tracks record, keyed by its epoch number.
+func (b *CheckpointsBookkeeper) Add(record *CheckpointRecord) {
+	b.records[record.EpochNum()] = record
+}
+
+// Get returns the tracked record for epochNum, if any.
+func (b *CheckpointsBookkeeper) Get(epochNum uint64) (*CheckpointRecord, bool) {
+	record, ok := b.records[epochNum]
+	return record, ok
+}
+
+// Records returns every tracked record, in no particular order.
+func (b *CheckpointsBookkeeper) Records() []*CheckpointRecord {
+	records := make([]*CheckpointRecord, 0, len(b.records))
+	for _, record := range b.records {
+		records = append(records, record)
+	}
+	return records
+}
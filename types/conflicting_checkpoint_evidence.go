@@ -0,0 +1,24 @@
+package types
+
+import (
+	checkpointingtypes "github.com/babylonchain/babylon/x/checkpointing/types"
+	btcctypes "github.com/babylonchain/babylon/x/btccheckpoint/types"
+)
+
+// ConflictingCheckpointEvidence is the fraud proof reported to Babylon when
+// the monitor observes two BTC checkpoints for the same epoch that carry
+// different LastCommitHash values. It lets Babylon (and anyone else) verify
+// independently, from the raw checkpoints and the BTC transactions that
+// carried them, that the two checkpoints genuinely conflict.
+type ConflictingCheckpointEvidence struct {
+	EpochNum uint64
+
+	// BtcCheckpoint is the checkpoint the monitor decoded from BTC that
+	// failed verification against Babylon's canonical checkpoint
+	BtcCheckpoint    *checkpointingtypes.RawCheckpoint
+	BtcSubmissionKey *btcctypes.SubmissionKey
+
+	// BbnCheckpoint is Babylon's canonical checkpoint for the same epoch
+	BbnCheckpoint    *checkpointingtypes.RawCheckpoint
+	BbnSubmissionKey *btcctypes.SubmissionKey
+}
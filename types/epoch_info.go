@@ -0,0 +1,37 @@
+package types
+
+import (
+	"fmt"
+
+	checkpointingtypes "github.com/babylonchain/babylon/x/checkpointing/types"
+)
+
+// EpochInfo is the validator set snapshot the monitor verifies checkpoints
+// against for a single epoch.
+type EpochInfo struct {
+	EpochNumber uint64
+	ValSet      checkpointingtypes.ValidatorWithBlsKeySet
+}
+
+// NewEpochInfo creates an EpochInfo for epochNumber, backed by valSet.
+func NewEpochInfo(epochNumber uint64, valSet checkpointingtypes.ValidatorWithBlsKeySet) *EpochInfo {
+	return &EpochInfo{
+		EpochNumber: epochNumber,
+		ValSet:      valSet,
+	}
+}
+
+// GetEpochNumber returns the epoch number this EpochInfo verifies against.
+func (ei *EpochInfo) GetEpochNumber() uint64 {
+	return ei.EpochNumber
+}
+
+// VerifyMultiSig checks that ckpt's BLS multisig was produced by a
+// supermajority of ei's validator set.
+func (ei *EpochInfo) VerifyMultiSig(ckpt *checkpointingtypes.RawCheckpoint) error {
+	if err := ckpt.VerifyMultiSig(ei.ValSet); err != nil {
+		return fmt.Errorf("BLS multisig verification failed for epoch %d: %w", ei.EpochNumber, err)
+	}
+
+	return nil
+}
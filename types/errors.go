@@ -0,0 +1,21 @@
+package types
+
+import sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+// ModuleName is used to register the monitor's sentinel errors in their own
+// error codespace, so callers can match them with sdkerrors.IsOf regardless
+// of which package wraps them.
+const ModuleName = "monitor"
+
+var (
+	// ErrInvalidEpochNum is returned when a BTC checkpoint's epoch number
+	// does not match the epoch the monitor currently expects.
+	ErrInvalidEpochNum = sdkerrors.Register(ModuleName, 2, "invalid epoch number")
+	// ErrInconsistentLastCommitHash is returned when a BLS-valid BTC
+	// checkpoint and Babylon's canonical checkpoint for the same epoch carry
+	// different LastCommitHash values, i.e. the BTC ledger is on a fork.
+	ErrInconsistentLastCommitHash = sdkerrors.Register(ModuleName, 3, "inconsistent last commit hash")
+	// ErrInvalidBLSSignature is returned when a checkpoint's BLS multisig
+	// does not verify against the expected validator set.
+	ErrInvalidBLSSignature = sdkerrors.Register(ModuleName, 4, "invalid BLS signature")
+)
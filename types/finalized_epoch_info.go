@@ -0,0 +1,24 @@
+package types
+
+import (
+	checkpointingtypes "github.com/babylonchain/babylon/x/checkpointing/types"
+	btcctypes "github.com/babylonchain/babylon/x/btccheckpoint/types"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+)
+
+// FinalizedEpochInfo describes an epoch whose checkpoint has been observed
+// on BTC, BLS-verified, and matched against Babylon's RawCheckpoint. It is
+// the unit the monitor's read API serves to downstream consumers (wallets,
+// rollup bridges) that want to gate on BTC-finality without running their
+// own BTC scanner.
+type FinalizedEpochInfo struct {
+	EpochNum      uint64
+	RawCheckpoint *checkpointingtypes.RawCheckpoint
+	BtcHeight     uint64
+	BtcBlockHash  chainhash.Hash
+
+	// Proofs are the SPV Merkle proofs of the OP_RETURN transactions that
+	// carried the checkpoint. They are always recorded; the read API only
+	// includes them in a response when requested with prove=true
+	Proofs []*btcctypes.BTCSpvProof
+}
@@ -0,0 +1,14 @@
+package types
+
+import checkpointingtypes "github.com/babylonchain/babylon/x/checkpointing/types"
+
+// GenesisInfo carries the genesis validator set the monitor bootstraps its
+// first epoch's verification against.
+type GenesisInfo struct {
+	GenesisValSet checkpointingtypes.ValidatorWithBlsKeySet
+}
+
+// GetBLSKeySet returns the genesis validator set and its BLS keys.
+func (g *GenesisInfo) GetBLSKeySet() checkpointingtypes.ValidatorWithBlsKeySet {
+	return g.GenesisValSet
+}
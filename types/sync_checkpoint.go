@@ -0,0 +1,40 @@
+package types
+
+import (
+	"encoding/binary"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+)
+
+// SyncCheckpoint is a signed, trusted checkpoint an operator can hand a fresh
+// Monitor so it can fast-sync instead of scanning Bitcoin from Babylon
+// genesis. EpochNum/BtcHeight/BtcBlockHash/ValSetHash are signed by a
+// threshold of operator keys; Signatures holds the signers' signatures in any
+// order, each one counted against whichever configured signer it verifies
+// for.
+type SyncCheckpoint struct {
+	EpochNum     uint64
+	BtcHeight    uint64
+	BtcBlockHash chainhash.Hash
+	ValSetHash   []byte
+	Signatures   [][]byte
+}
+
+// SignBytes returns the canonical byte encoding of the checkpoint fields that
+// are signed, i.e. everything except the signatures themselves.
+func (c *SyncCheckpoint) SignBytes() []byte {
+	buf := make([]byte, 0, 16+chainhash.HashSize+len(c.ValSetHash))
+
+	epochBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(epochBytes, c.EpochNum)
+	buf = append(buf, epochBytes...)
+
+	heightBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(heightBytes, c.BtcHeight)
+	buf = append(buf, heightBytes...)
+
+	buf = append(buf, c.BtcBlockHash[:]...)
+	buf = append(buf, c.ValSetHash...)
+
+	return buf
+}